@@ -0,0 +1,658 @@
+package convert
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// bigFloatPrec is the precision, in bits, of the *big.Float values parsed from
+// non-integer strings or converted from *big.Rat values. Integers keep all their bits.
+const bigFloatPrec = 256
+
+var (
+	bigIntType   = reflect.TypeFor[*big.Int]()
+	bigFloatType = reflect.TypeFor[*big.Float]()
+	bigRatType   = reflect.TypeFor[*big.Rat]()
+)
+
+// BigIntConverter is a function type for custom *big.Int conversion.
+// It takes any value and returns a *big.Int if conversion succeeds, or nil if it fails.
+type BigIntConverter func(value interface{}) *big.Int
+
+// BigIntArrayConverter is a function type for custom *big.Int array conversion.
+type BigIntArrayConverter func(value interface{}) *[]*big.Int
+
+// BigFloatConverter is a function type for custom *big.Float conversion.
+// It takes any value and returns a *big.Float if conversion succeeds, or nil if it fails.
+type BigFloatConverter func(value interface{}) *big.Float
+
+// BigFloatArrayConverter is a function type for custom *big.Float array conversion.
+type BigFloatArrayConverter func(value interface{}) *[]*big.Float
+
+// BigRatConverter is a function type for custom *big.Rat conversion.
+// It takes any value and returns a *big.Rat if conversion succeeds, or nil if it fails.
+type BigRatConverter func(value interface{}) *big.Rat
+
+// BigRatArrayConverter is a function type for custom *big.Rat array conversion.
+type BigRatArrayConverter func(value interface{}) *[]*big.Rat
+
+// roundRat rounds r to an integer with the rounding mode of c.
+// In strict mode and with RoundError, a fractional part is reported as ErrPrecisionLoss.
+func (c *Converter) roundRat(value interface{}, r *big.Rat, to reflect.Type) (*big.Int, error) {
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num()), nil
+	}
+	if c.opts.strict || c.opts.rounding == RoundError {
+		return nil, newError(value, to, ErrPrecisionLoss)
+	}
+	q, _ := quoRound(r.Num(), r.Denom(), c.opts.rounding)
+	return q, nil
+}
+
+// quoRound returns num/den rounded to an integer with the given mode, and whether the
+// division is exact. den must be positive. RoundError rounds like RoundTruncate.
+func quoRound(num, den *big.Int, mode RoundingMode) (*big.Int, bool) {
+	q, m := new(big.Int).QuoRem(num, den, new(big.Int))
+	if m.Sign() == 0 {
+		return q, true
+	}
+
+	away := false
+	switch mode {
+	case RoundFloor:
+		away = num.Sign() < 0
+	case RoundCeil:
+		away = num.Sign() > 0
+	case RoundHalfUp, RoundHalfEven:
+		switch new(big.Int).Lsh(m.Abs(m), 1).Cmp(den) {
+		case 1:
+			away = true
+		case 0:
+			away = mode == RoundHalfUp || q.Bit(0) == 1
+		}
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(num.Sign())))
+	}
+	return q, false
+}
+
+// bigToInteger converts a big.Int, big.Float or big.Rat to the integer type T,
+// rounding with the rounding mode of c. Values outside the range of T are reported as ErrOverflow.
+func bigToInteger[T integer](c *Converter, value interface{}, n interface{}) (T, error) {
+	to := reflect.TypeFor[T]()
+
+	var bi *big.Int
+	switch n := n.(type) {
+	case big.Int:
+		bi = &n
+	case big.Float:
+		if n.IsInf() {
+			return 0, overflowError(value, to, n.Sign())
+		}
+		r, _ := n.Rat(nil)
+		res, err := c.roundRat(value, r, to)
+		if err != nil {
+			return 0, err
+		}
+		bi = res
+	case big.Rat:
+		res, err := c.roundRat(value, &n, to)
+		if err != nil {
+			return 0, err
+		}
+		bi = res
+	}
+
+	size := to.Bits()
+	switch to.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if bi.Sign() < 0 || bi.BitLen() > size {
+			return 0, overflowError(value, to, bi.Sign())
+		}
+		return T(bi.Uint64()), nil
+	default:
+		abs := bi
+		if bi.Sign() < 0 {
+			// -2^(size-1) is the only negative value with size bits that fits.
+			abs = new(big.Int).Add(bi, big.NewInt(1))
+		}
+		if abs.BitLen() >= size {
+			return 0, overflowError(value, to, bi.Sign())
+		}
+		return T(bi.Int64()), nil
+	}
+}
+
+// ToBigIntE converts any type of value to a *big.Int or returns an error.
+// It handles various types including:
+//   - *big.Int (copied), *big.Float and *big.Rat
+//   - json.Number, parsed exactly
+//   - integer and unsigned integer types, of any size
+//   - float types, rounded with the rounding mode of the converter
+//   - strings of any length, decimal or with a 0x, 0o or 0b prefix as ToIntE accepts,
+//     and decimal fractions or exponents like "1.5e30", rounded like floats
+//   - bool
+//
+// NaN and infinities are reported as ErrOverflow.
+//
+// Example:
+//
+//	n, err := ToBigIntE("0xffffffffffffffffffffffffffffffff")
+//	fmt.Println(n) // Output: 340282366920938463463374607431768211455
+func ToBigIntE(value interface{}, converters ...BigIntConverter) (*big.Int, error) {
+	return std.ToBigIntE(value, converters...)
+}
+
+// ToBigIntE is like the package-level ToBigIntE but uses the options of c.
+func (c *Converter) ToBigIntE(value interface{}, converters ...BigIntConverter) (*big.Int, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[*big.Int](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, bigIntType)
+	if err != nil {
+		return nil, err
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, bigIntType); err != nil {
+		return nil, err
+	}
+	if err := c.strictNumber(i, bigIntType); err != nil {
+		return nil, err
+	}
+
+	switch n := i.(type) {
+	case string:
+		if len(n) == 0 {
+			if err := c.emptyString(bigIntType); err != nil {
+				return nil, err
+			}
+			return new(big.Int), nil
+		}
+		if res, ok := c.parseBigInt(n); ok {
+			return res, nil
+		} else if c.opts.integerBase != 0 {
+			return nil, newError(n, bigIntType, ErrSyntax)
+		} else if r, ok := new(big.Rat).SetString(n); ok {
+			return c.roundRat(n, r, bigIntType)
+		} else if isInfString(n) {
+			return nil, newError(n, bigIntType, ErrOverflow)
+		} else if resBool, err := strconv.ParseBool(n); err == nil {
+			return c.ToBigIntE(resBool)
+		} else {
+			return nil, newError(n, bigIntType, ErrSyntax)
+		}
+	case big.Int:
+		return new(big.Int).Set(&n), nil
+	case big.Float:
+		if n.IsInf() {
+			return nil, newError(value, bigIntType, ErrOverflow)
+		}
+		r, _ := n.Rat(nil)
+		return c.roundRat(value, r, bigIntType)
+	case big.Rat:
+		return c.roundRat(value, &n, bigIntType)
+	case int, int8, int16, int32, int64:
+		return big.NewInt(reflect.ValueOf(n).Int()), nil
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return new(big.Int).SetUint64(reflect.ValueOf(n).Uint()), nil
+	case float32, float64:
+		f := reflect.ValueOf(n).Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, newError(value, bigIntType, ErrOverflow)
+		}
+		return c.roundRat(value, new(big.Rat).SetFloat64(f), bigIntType)
+	case bool:
+		if n {
+			return big.NewInt(1), nil
+		}
+		return new(big.Int), nil
+	default:
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToBigIntE(valueStr)
+		return res, stringFormError(err, value, bigIntType)
+	}
+}
+
+// ToBigFloatE converts any type of value to a *big.Float or returns an error.
+// It handles various types including:
+//   - *big.Float (copied), *big.Int and *big.Rat
+//   - integer, unsigned integer and float types, converted exactly
+//   - strings: integers of any length are kept exactly, other numbers, including
+//     hexadecimal floats like "0x1p-2" and "Inf", are parsed with a precision of 256 bits
+//   - bool
+//
+// NaN cannot be represented by a *big.Float and is reported as ErrOverflow.
+func ToBigFloatE(value interface{}, converters ...BigFloatConverter) (*big.Float, error) {
+	return std.ToBigFloatE(value, converters...)
+}
+
+// ToBigFloatE is like the package-level ToBigFloatE but uses the options of c.
+func (c *Converter) ToBigFloatE(value interface{}, converters ...BigFloatConverter) (*big.Float, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[*big.Float](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, bigFloatType)
+	if err != nil {
+		return nil, err
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, bigFloatType); err != nil {
+		return nil, err
+	}
+	if err := c.strictNumber(i, bigFloatType); err != nil {
+		return nil, err
+	}
+
+	switch n := i.(type) {
+	case string:
+		if len(n) == 0 {
+			if err := c.emptyString(bigFloatType); err != nil {
+				return nil, err
+			}
+			return new(big.Float), nil
+		}
+		if res, ok := c.parseBigInt(n); ok {
+			return new(big.Float).SetInt(res), nil
+		} else if res, _, err := new(big.Float).SetPrec(bigFloatPrec).Parse(n, 0); err == nil {
+			return res, nil
+		} else if resBool, err := strconv.ParseBool(n); err == nil {
+			return c.ToBigFloatE(resBool)
+		} else {
+			return nil, newError(n, bigFloatType, ErrSyntax)
+		}
+	case big.Float:
+		return new(big.Float).Copy(&n), nil
+	case big.Int:
+		return new(big.Float).SetInt(&n), nil
+	case big.Rat:
+		return new(big.Float).SetPrec(bigFloatPrec).SetRat(&n), nil
+	case int, int8, int16, int32, int64:
+		return new(big.Float).SetInt64(reflect.ValueOf(n).Int()), nil
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return new(big.Float).SetUint64(reflect.ValueOf(n).Uint()), nil
+	case float32, float64:
+		f := reflect.ValueOf(n).Float()
+		if math.IsNaN(f) {
+			return nil, newError(value, bigFloatType, ErrOverflow)
+		}
+		return big.NewFloat(f), nil
+	case bool:
+		if n {
+			return big.NewFloat(1), nil
+		}
+		return new(big.Float), nil
+	default:
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToBigFloatE(valueStr)
+		return res, stringFormError(err, value, bigFloatType)
+	}
+}
+
+// ToBigRatE converts any type of value to a *big.Rat or returns an error.
+// It handles various types including:
+//   - *big.Rat (copied), *big.Int and *big.Float
+//   - integer, unsigned integer and float types, converted exactly
+//   - strings: integers with an optional 0x, 0o or 0b prefix, fractions like "3/4",
+//     and decimals with an optional exponent like "1.25e-3"
+//   - bool
+//
+// NaN and infinities are reported as ErrOverflow.
+func ToBigRatE(value interface{}, converters ...BigRatConverter) (*big.Rat, error) {
+	return std.ToBigRatE(value, converters...)
+}
+
+// ToBigRatE is like the package-level ToBigRatE but uses the options of c.
+func (c *Converter) ToBigRatE(value interface{}, converters ...BigRatConverter) (*big.Rat, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[*big.Rat](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, bigRatType)
+	if err != nil {
+		return nil, err
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, bigRatType); err != nil {
+		return nil, err
+	}
+	if err := c.strictNumber(i, bigRatType); err != nil {
+		return nil, err
+	}
+
+	switch n := i.(type) {
+	case string:
+		if len(n) == 0 {
+			if err := c.emptyString(bigRatType); err != nil {
+				return nil, err
+			}
+			return new(big.Rat), nil
+		}
+		if res, ok := c.parseBigInt(n); ok {
+			return new(big.Rat).SetInt(res), nil
+		} else if res, ok := new(big.Rat).SetString(n); ok {
+			return res, nil
+		} else if isInfString(n) {
+			return nil, newError(n, bigRatType, ErrOverflow)
+		} else if resBool, err := strconv.ParseBool(n); err == nil {
+			return c.ToBigRatE(resBool)
+		} else {
+			return nil, newError(n, bigRatType, ErrSyntax)
+		}
+	case big.Rat:
+		return new(big.Rat).Set(&n), nil
+	case big.Int:
+		return new(big.Rat).SetInt(&n), nil
+	case big.Float:
+		if n.IsInf() {
+			return nil, newError(value, bigRatType, ErrOverflow)
+		}
+		res, _ := n.Rat(nil)
+		return res, nil
+	case int, int8, int16, int32, int64:
+		return new(big.Rat).SetInt64(reflect.ValueOf(n).Int()), nil
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return new(big.Rat).SetUint64(reflect.ValueOf(n).Uint()), nil
+	case float32, float64:
+		f := reflect.ValueOf(n).Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, newError(value, bigRatType, ErrOverflow)
+		}
+		return new(big.Rat).SetFloat64(f), nil
+	case bool:
+		if n {
+			return big.NewRat(1, 1), nil
+		}
+		return new(big.Rat), nil
+	default:
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToBigRatE(valueStr)
+		return res, stringFormError(err, value, bigRatType)
+	}
+}
+
+// ToBigInt converts any type of value to a *big.Int, ignoring errors.
+func ToBigInt(value interface{}, converters ...BigIntConverter) *big.Int {
+	return std.ToBigInt(value, converters...)
+}
+
+// ToBigInt is like the package-level ToBigInt but uses the options of c.
+func (c *Converter) ToBigInt(value interface{}, converters ...BigIntConverter) *big.Int {
+	res, _ := c.ToBigIntE(value, converters...)
+	return res
+}
+
+// ToBigIntOrDefault converts any type of value to a *big.Int or returns the provided default value if conversion fails.
+func ToBigIntOrDefault(value interface{}, defaultValue *big.Int, converters ...BigIntConverter) *big.Int {
+	return std.ToBigIntOrDefault(value, defaultValue, converters...)
+}
+
+// ToBigIntOrDefault is like the package-level ToBigIntOrDefault but uses the options of c.
+func (c *Converter) ToBigIntOrDefault(value interface{}, defaultValue *big.Int, converters ...BigIntConverter) *big.Int {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToBigIntE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToBigIntArrayE converts any type of value to an array of *big.Int or returns an error.
+// Every element is converted with ToBigIntE.
+func ToBigIntArrayE(value interface{}, converters ...BigIntArrayConverter) ([]*big.Int, error) {
+	return std.ToBigIntArrayE(value, converters...)
+}
+
+// ToBigIntArrayE is like the package-level ToBigIntArrayE but uses the options of c.
+func (c *Converter) ToBigIntArrayE(value interface{}, converters ...BigIntArrayConverter) ([]*big.Int, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[[]*big.Int](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Array, reflect.Slice:
+		v := reflect.ValueOf(value)
+		resArray := make([]*big.Int, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			res, err := c.ToBigIntE(v.Index(i).Interface())
+			if err != nil {
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
+			}
+			resArray[i] = res
+		}
+		return resArray, nil
+	default:
+		return nil, unsupportedError(value, reflect.TypeFor[[]*big.Int]())
+	}
+}
+
+// ToBigIntArray converts any type of value to an array of *big.Int, ignoring errors.
+func ToBigIntArray(value interface{}, converters ...BigIntArrayConverter) []*big.Int {
+	return std.ToBigIntArray(value, converters...)
+}
+
+// ToBigIntArray is like the package-level ToBigIntArray but uses the options of c.
+func (c *Converter) ToBigIntArray(value interface{}, converters ...BigIntArrayConverter) []*big.Int {
+	res, _ := c.ToBigIntArrayE(value, converters...)
+	return res
+}
+
+// ToBigIntArrayOrDefault converts any type of value to an array of *big.Int or returns the provided default array if conversion fails.
+func ToBigIntArrayOrDefault(value interface{}, defaultValue []*big.Int, converters ...BigIntArrayConverter) []*big.Int {
+	return std.ToBigIntArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToBigIntArrayOrDefault is like the package-level ToBigIntArrayOrDefault but uses the options of c.
+func (c *Converter) ToBigIntArrayOrDefault(value interface{}, defaultValue []*big.Int, converters ...BigIntArrayConverter) []*big.Int {
+	res, err := c.ToBigIntArrayE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToBigFloat converts any type of value to a *big.Float, ignoring errors.
+func ToBigFloat(value interface{}, converters ...BigFloatConverter) *big.Float {
+	return std.ToBigFloat(value, converters...)
+}
+
+// ToBigFloat is like the package-level ToBigFloat but uses the options of c.
+func (c *Converter) ToBigFloat(value interface{}, converters ...BigFloatConverter) *big.Float {
+	res, _ := c.ToBigFloatE(value, converters...)
+	return res
+}
+
+// ToBigFloatOrDefault converts any type of value to a *big.Float or returns the provided default value if conversion fails.
+func ToBigFloatOrDefault(value interface{}, defaultValue *big.Float, converters ...BigFloatConverter) *big.Float {
+	return std.ToBigFloatOrDefault(value, defaultValue, converters...)
+}
+
+// ToBigFloatOrDefault is like the package-level ToBigFloatOrDefault but uses the options of c.
+func (c *Converter) ToBigFloatOrDefault(value interface{}, defaultValue *big.Float, converters ...BigFloatConverter) *big.Float {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToBigFloatE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToBigFloatArrayE converts any type of value to an array of *big.Float or returns an error.
+// Every element is converted with ToBigFloatE.
+func ToBigFloatArrayE(value interface{}, converters ...BigFloatArrayConverter) ([]*big.Float, error) {
+	return std.ToBigFloatArrayE(value, converters...)
+}
+
+// ToBigFloatArrayE is like the package-level ToBigFloatArrayE but uses the options of c.
+func (c *Converter) ToBigFloatArrayE(value interface{}, converters ...BigFloatArrayConverter) ([]*big.Float, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[[]*big.Float](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Array, reflect.Slice:
+		v := reflect.ValueOf(value)
+		resArray := make([]*big.Float, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			res, err := c.ToBigFloatE(v.Index(i).Interface())
+			if err != nil {
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
+			}
+			resArray[i] = res
+		}
+		return resArray, nil
+	default:
+		return nil, unsupportedError(value, reflect.TypeFor[[]*big.Float]())
+	}
+}
+
+// ToBigFloatArray converts any type of value to an array of *big.Float, ignoring errors.
+func ToBigFloatArray(value interface{}, converters ...BigFloatArrayConverter) []*big.Float {
+	return std.ToBigFloatArray(value, converters...)
+}
+
+// ToBigFloatArray is like the package-level ToBigFloatArray but uses the options of c.
+func (c *Converter) ToBigFloatArray(value interface{}, converters ...BigFloatArrayConverter) []*big.Float {
+	res, _ := c.ToBigFloatArrayE(value, converters...)
+	return res
+}
+
+// ToBigFloatArrayOrDefault converts any type of value to an array of *big.Float or returns the provided default array if conversion fails.
+func ToBigFloatArrayOrDefault(value interface{}, defaultValue []*big.Float, converters ...BigFloatArrayConverter) []*big.Float {
+	return std.ToBigFloatArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToBigFloatArrayOrDefault is like the package-level ToBigFloatArrayOrDefault but uses the options of c.
+func (c *Converter) ToBigFloatArrayOrDefault(value interface{}, defaultValue []*big.Float, converters ...BigFloatArrayConverter) []*big.Float {
+	res, err := c.ToBigFloatArrayE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToBigRat converts any type of value to a *big.Rat, ignoring errors.
+func ToBigRat(value interface{}, converters ...BigRatConverter) *big.Rat {
+	return std.ToBigRat(value, converters...)
+}
+
+// ToBigRat is like the package-level ToBigRat but uses the options of c.
+func (c *Converter) ToBigRat(value interface{}, converters ...BigRatConverter) *big.Rat {
+	res, _ := c.ToBigRatE(value, converters...)
+	return res
+}
+
+// ToBigRatOrDefault converts any type of value to a *big.Rat or returns the provided default value if conversion fails.
+func ToBigRatOrDefault(value interface{}, defaultValue *big.Rat, converters ...BigRatConverter) *big.Rat {
+	return std.ToBigRatOrDefault(value, defaultValue, converters...)
+}
+
+// ToBigRatOrDefault is like the package-level ToBigRatOrDefault but uses the options of c.
+func (c *Converter) ToBigRatOrDefault(value interface{}, defaultValue *big.Rat, converters ...BigRatConverter) *big.Rat {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToBigRatE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToBigRatArrayE converts any type of value to an array of *big.Rat or returns an error.
+// Every element is converted with ToBigRatE.
+func ToBigRatArrayE(value interface{}, converters ...BigRatArrayConverter) ([]*big.Rat, error) {
+	return std.ToBigRatArrayE(value, converters...)
+}
+
+// ToBigRatArrayE is like the package-level ToBigRatArrayE but uses the options of c.
+func (c *Converter) ToBigRatArrayE(value interface{}, converters ...BigRatArrayConverter) ([]*big.Rat, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[[]*big.Rat](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Array, reflect.Slice:
+		v := reflect.ValueOf(value)
+		resArray := make([]*big.Rat, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			res, err := c.ToBigRatE(v.Index(i).Interface())
+			if err != nil {
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
+			}
+			resArray[i] = res
+		}
+		return resArray, nil
+	default:
+		return nil, unsupportedError(value, reflect.TypeFor[[]*big.Rat]())
+	}
+}
+
+// ToBigRatArray converts any type of value to an array of *big.Rat, ignoring errors.
+func ToBigRatArray(value interface{}, converters ...BigRatArrayConverter) []*big.Rat {
+	return std.ToBigRatArray(value, converters...)
+}
+
+// ToBigRatArray is like the package-level ToBigRatArray but uses the options of c.
+func (c *Converter) ToBigRatArray(value interface{}, converters ...BigRatArrayConverter) []*big.Rat {
+	res, _ := c.ToBigRatArrayE(value, converters...)
+	return res
+}
+
+// ToBigRatArrayOrDefault converts any type of value to an array of *big.Rat or returns the provided default array if conversion fails.
+func ToBigRatArrayOrDefault(value interface{}, defaultValue []*big.Rat, converters ...BigRatArrayConverter) []*big.Rat {
+	return std.ToBigRatArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToBigRatArrayOrDefault is like the package-level ToBigRatArrayOrDefault but uses the options of c.
+func (c *Converter) ToBigRatArrayOrDefault(value interface{}, defaultValue []*big.Rat, converters ...BigRatArrayConverter) []*big.Rat {
+	res, err := c.ToBigRatArrayE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
@@ -0,0 +1,239 @@
+package convert
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func bigIntOf(s string) *big.Int {
+	n, _ := new(big.Int).SetString(s, 0)
+	return n
+}
+
+func TestToBigIntE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected string
+		err      error
+	}{
+		{42, "42", nil},
+		{int8(-3), "-3", nil},
+		{uint64(math.MaxUint64), "18446744073709551615", nil},
+		{3.9, "3", nil},
+		{-3.9, "-3", nil},
+		{1e30, "1000000000000000019884624838656", nil},
+		{true, "1", nil},
+		{"123456789012345678901234567890", "123456789012345678901234567890", nil},
+		{"0xffffffffffffffffffffffffffffffff", "340282366920938463463374607431768211455", nil},
+		{"0o777", "511", nil},
+		{"0b1010", "10", nil},
+		{"1_000", "1000", nil},
+		{"1.5e30", "1500000000000000000000000000000", nil},
+		{"7/2", "3", nil},
+		{"", "0", nil},
+		{[]byte("99"), "99", nil},
+		{big.NewInt(5), "5", nil},
+		{big.NewFloat(2.75), "2", nil},
+		{big.NewRat(-7, 2), "-3", nil},
+		{namedPort(80), "80", nil},
+		{"abc", "", ErrSyntax},
+		{"Inf", "", ErrOverflow},
+		{math.NaN(), "", ErrOverflow},
+		{math.Inf(1), "", ErrOverflow},
+	}
+
+	for _, test := range tests {
+		res, err := ToBigIntE(test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %#v", test.input)
+			continue
+		}
+		if assert.NoError(t, err, "input %#v", test.input) {
+			assert.Equal(t, test.expected, res.String(), "input %#v", test.input)
+		}
+	}
+
+	src := big.NewInt(1)
+	res := ToBigInt(src)
+	res.SetInt64(2)
+	assert.Equal(t, int64(1), src.Int64(), "the result must be a copy")
+
+	assert.Equal(t, big.NewInt(9), ToBigIntOrDefault("x", big.NewInt(9)))
+	assert.Equal(t, "0", ToBigInt(nil).String())
+}
+
+func TestToBigIntRounding(t *testing.T) {
+	tests := []struct {
+		mode     RoundingMode
+		input    interface{}
+		expected int64
+	}{
+		{RoundHalfUp, "2.5", 3},
+		{RoundHalfUp, "-2.5", -3},
+		{RoundHalfEven, "2.5", 2},
+		{RoundHalfEven, "3.5", 4},
+		{RoundHalfEven, big.NewRat(-5, 2), -2},
+		{RoundFloor, "-2.1", -3},
+		{RoundCeil, "2.1", 3},
+		{RoundTruncate, "-2.9", -2},
+	}
+
+	for _, test := range tests {
+		res, err := New(WithRounding(test.mode)).ToBigIntE(test.input)
+		if assert.NoError(t, err, "%v %v", test.mode, test.input) {
+			assert.Equal(t, test.expected, res.Int64(), "%v %v", test.mode, test.input)
+		}
+	}
+
+	_, err := New(WithRounding(RoundError)).ToBigIntE("2.5")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = Strict().ToBigIntE(big.NewFloat(0.5))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = Strict().ToBigIntE(true)
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = New(WithEmptyStringAsZero(false)).ToBigIntE("")
+	assert.ErrorIs(t, err, ErrSyntax)
+}
+
+func TestToBigFloatE(t *testing.T) {
+	f, err := ToBigFloatE("123456789012345678901234567890.123456789")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "123456789012345678901234567890.123456789", f.Text('f', 9))
+	}
+
+	f, err = ToBigFloatE("0xffffffffffffffffffffffffffffffff")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "340282366920938463463374607431768211455", f.Text('f', 0))
+	}
+
+	f, err = ToBigFloatE("0x1p-2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0.25", f.String())
+	}
+
+	f, err = ToBigFloatE("-Inf")
+	if assert.NoError(t, err) {
+		assert.True(t, f.IsInf())
+	}
+
+	assert.Equal(t, "1.5", ToBigFloat(1.5).String())
+	assert.Equal(t, "18446744073709551615", ToBigFloat(uint64(math.MaxUint64)).Text('f', 0))
+	assert.Equal(t, "0.75", ToBigFloat(big.NewRat(3, 4)).String())
+	assert.Equal(t, "1", ToBigFloat(true).String())
+
+	_, err = ToBigFloatE(math.NaN())
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = ToBigFloatE("abc")
+	assert.ErrorIs(t, err, ErrSyntax)
+}
+
+func TestToBigRatE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected string
+		err      error
+	}{
+		{"3/4", "3/4", nil},
+		{"1.25e-3", "1/800", nil},
+		{"0x10", "16/1", nil},
+		{0.1, "3602879701896397/36028797018963968", nil},
+		{-2, "-2/1", nil},
+		{uint64(math.MaxUint64), "18446744073709551615/1", nil},
+		{big.NewInt(7), "7/1", nil},
+		{big.NewFloat(0.5), "1/2", nil},
+		{false, "0/1", nil},
+		{"1/0", "", ErrSyntax},
+		{"Inf", "", ErrOverflow},
+		{math.Inf(-1), "", ErrOverflow},
+	}
+
+	for _, test := range tests {
+		res, err := ToBigRatE(test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %#v", test.input)
+			continue
+		}
+		if assert.NoError(t, err, "input %#v", test.input) {
+			assert.Equal(t, test.expected, res.String(), "input %#v", test.input)
+		}
+	}
+}
+
+func TestIntegerFromBig(t *testing.T) {
+	n, err := ToInt64E(big.NewInt(math.MaxInt64))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), n)
+
+	_, err = ToInt64E(bigIntOf("9223372036854775808"))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	m, err := ToInt64E(bigIntOf("-9223372036854775808"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), m)
+
+	_, err = ToInt64E(bigIntOf("-9223372036854775809"))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	u, err := ToUint64E(bigIntOf("18446744073709551615"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), u)
+
+	_, err = ToUint64E(bigIntOf("18446744073709551616"))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = ToUintE(big.NewInt(-1))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = ToInt8E(big.NewInt(128))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	i8, err := ToInt8E(big.NewInt(-128))
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-128), i8)
+
+	assert.Equal(t, 3, ToInt(big.NewRat(7, 2)))
+	assert.Equal(t, 4, New(WithRounding(RoundHalfUp)).ToInt(big.NewRat(7, 2)))
+	assert.Equal(t, uint16(2), ToUint16(big.NewFloat(2.5)))
+
+	_, err = ToInt32E(new(big.Float).SetInf(false))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = Strict().ToIntE(big.NewRat(1, 2))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestBigCollections(t *testing.T) {
+	ints, err := ToBigIntArrayE([]interface{}{"0x10", 2, "1e20"})
+	if assert.NoError(t, err) && assert.Len(t, ints, 3) {
+		assert.Equal(t, "16", ints[0].String())
+		assert.Equal(t, "100000000000000000000", ints[2].String())
+	}
+
+	_, err = ToBigRatArrayE([]string{"1/2", "x"})
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, "[1]", convErr.Path)
+	}
+
+	m, err := ToMapStringBigIntE(`{"wei": 123456789012345678901234567890, "gwei": "0x3b9aca00"}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "123456789012345678901234567890", m["wei"].String())
+		assert.Equal(t, "1000000000", m["gwei"].String())
+	}
+
+	floats, err := ToMapStringBigFloatE(map[string]interface{}{"pi": "3.14159265358979323846264338327950288"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "3.14159265358979323846264338327950288", floats["pi"].Text('f', 35))
+	}
+
+	_, err = ToMapStringBigRatE(`{"a": 1} x`)
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	got, err := ToE[*big.Int]("0xff")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(255), got.Int64())
+
+	rats, err := ToE[map[string]*big.Rat](map[string]string{"half": "1/2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1/2", rats["half"].String())
+}
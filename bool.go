@@ -1,7 +1,7 @@
 package convert
 
 import (
-	"fmt"
+	"reflect"
 	"strings"
 )
 
@@ -47,7 +47,12 @@ type BoolConvert func(value interface{}) *bool
 //	}
 //	customBool := ToBool(myCustomValue, customConverter)
 func ToBool(value interface{}, converters ...BoolConvert) bool {
-	res, _ := ToBoolE(value, converters...)
+	return std.ToBool(value, converters...)
+}
+
+// ToBool is like the package-level ToBool but uses the options of c.
+func (c *Converter) ToBool(value interface{}, converters ...BoolConvert) bool {
+	res, _ := c.ToBoolE(value, converters...)
 	return res
 }
 
@@ -80,10 +85,15 @@ func ToBool(value interface{}, converters ...BoolConvert) bool {
 //	}
 //	customBool := ToBoolOrDefault(myCustomValue, false, customConverter)
 func ToBoolOrDefault(value interface{}, defaultValue bool, converters ...BoolConvert) bool {
+	return std.ToBoolOrDefault(value, defaultValue, converters...)
+}
+
+// ToBoolOrDefault is like the package-level ToBoolOrDefault but uses the options of c.
+func (c *Converter) ToBoolOrDefault(value interface{}, defaultValue bool, converters ...BoolConvert) bool {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToBoolE(value, converters...)
+	res, err := c.ToBoolE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -101,6 +111,7 @@ func ToBoolOrDefault(value interface{}, defaultValue bool, converters ...BoolCon
 // "1", "t", "T", "true", "TRUE", "True", "y", "yes", "Y", "YES", "Yes", "ok", "OK", "on", "ON"
 // And the following values as false:
 // "0", "f", "F", "false", "FALSE", "False", "n", "no", "N", "NO", "No", "off", "OFF"
+// A Converter created with WithBoolStrings accepts its own set of values instead.
 //
 // Parameters:
 //   - value: The value to be converted to bool.
@@ -127,13 +138,29 @@ func ToBoolOrDefault(value interface{}, defaultValue bool, converters ...BoolCon
 //	}
 //	customBool, err := ToBoolE(myCustomValue, customConverter)
 func ToBoolE(value interface{}, converters ...BoolConvert) (bool, error) {
+	return std.ToBoolE(value, converters...)
+}
+
+// ToBoolE is like the package-level ToBoolE but uses the options of c.
+func (c *Converter) ToBoolE(value interface{}, converters ...BoolConvert) (bool, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	v := Indirect(value)
+	if res, ok, err := fromRegistry[bool](value); ok {
+		return res, err
+	}
+
+	v, err := indirectValue(value, reflect.TypeFor[bool]())
+	if err != nil {
+		return false, err
+	}
+	v = basicValue(v)
+	if err := c.strictBool(v); err != nil {
+		return false, err
+	}
 
 	switch b := v.(type) {
 	case bool:
@@ -147,17 +174,17 @@ func ToBoolE(value interface{}, converters ...BoolConvert) (bool, error) {
 	case float32, float64:
 		return b != 0, nil
 	case string:
-		switch strings.ToLower(b) {
-		case "1", "t", "true", "y", "yes", "ok", "on":
+		lower := strings.ToLower(b)
+		if _, ok := c.opts.trueStrings[lower]; ok {
 			return true, nil
-		case "0", "f", "false", "n", "no", "off", "":
+		}
+		if _, ok := c.opts.falseStrings[lower]; ok {
 			return false, nil
-		default:
-			return false, fmt.Errorf("convert: %v to boolean failed", value)
 		}
+		return false, newError(value, reflect.TypeFor[bool](), ErrSyntax)
 	case []byte:
-		return ToBoolE(string(b))
+		return c.ToBoolE(string(b))
 	default:
-		return false, fmt.Errorf("convert: %v (%T) to boolean failed", value, value)
+		return false, unsupportedError(value, reflect.TypeFor[bool]())
 	}
 }
@@ -0,0 +1,285 @@
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a number of bytes, like the "10MiB" or "1.5GB" of configuration files.
+// It implements encoding.TextMarshaler and encoding.TextUnmarshaler, and its JSON form
+// is a string like "10MiB", while both strings and numbers are accepted when unmarshalling.
+type ByteSize uint64
+
+// SI units, in powers of 1000.
+const (
+	Byte ByteSize = 1
+	KB            = 1000 * Byte
+	MB            = 1000 * KB
+	GB            = 1000 * MB
+	TB            = 1000 * GB
+	PB            = 1000 * TB
+	EB            = 1000 * PB
+)
+
+// IEC units, in powers of 1024.
+const (
+	KiB = 1024 * Byte
+	MiB = 1024 * KiB
+	GiB = 1024 * MiB
+	TiB = 1024 * GiB
+	PiB = 1024 * TiB
+	EiB = 1024 * PiB
+)
+
+var byteSizeType = reflect.TypeFor[ByteSize]()
+
+// byteSizeUnits are the units of ByteSize.String, from the largest to the smallest.
+var byteSizeUnits = []struct {
+	name string
+	size ByteSize
+}{
+	{"EiB", EiB}, {"EB", EB}, {"PiB", PiB}, {"PB", PB}, {"TiB", TiB}, {"TB", TB},
+	{"GiB", GiB}, {"GB", GB}, {"MiB", MiB}, {"MB", MB}, {"KiB", KiB}, {"kB", KB}, {"B", Byte},
+}
+
+// byteSizeSuffixes maps the lower-cased suffixes accepted by ToByteSizeE to their size.
+// Single letters are SI units, like in "10M".
+var byteSizeSuffixes = map[string]ByteSize{
+	"": Byte, "b": Byte, "byte": Byte, "bytes": Byte,
+	"k": KB, "kb": KB, "ki": KiB, "kib": KiB,
+	"m": MB, "mb": MB, "mi": MiB, "mib": MiB,
+	"g": GB, "gb": GB, "gi": GiB, "gib": GiB,
+	"t": TB, "tb": TB, "ti": TiB, "tib": TiB,
+	"p": PB, "pb": PB, "pi": PiB, "pib": PiB,
+	"e": EB, "eb": EB, "ei": EiB, "eib": EiB,
+}
+
+// ByteSizeConverter is a function type for custom ByteSize conversion.
+// It takes any value and returns a pointer to a ByteSize if conversion succeeds, or nil if it fails.
+type ByteSizeConverter func(value interface{}) *ByteSize
+
+// ParseByteSize parses a size like "512", "10MiB", "1.5 GB" or "64k".
+// It is a shortcut for ToByteSizeE with a string.
+func ParseByteSize(s string) (ByteSize, error) {
+	return std.ToByteSizeE(s)
+}
+
+// String formats s with the largest unit, SI or IEC, that represents it exactly
+// with at most two decimals, like "10MiB", "1.5GB" or "1023B". ParseByteSize parses it back.
+func (s ByteSize) String() string {
+	for _, u := range byteSizeUnits {
+		if s < u.size {
+			continue
+		}
+		q, r := uint64(s/u.size), uint64(s%u.size)
+		hi, lo := bits.Mul64(r, 100)
+		if u.size > 100 {
+			if _, rem := bits.Div64(hi, lo, uint64(u.size)); rem != 0 {
+				continue
+			}
+		} else if r != 0 {
+			continue
+		}
+		res := strconv.FormatUint(q, 10)
+		if r != 0 {
+			cents, _ := bits.Div64(hi, lo, uint64(u.size))
+			res += "." + strings.TrimRight(strconv.FormatUint(100+cents, 10)[1:], "0")
+		}
+		return res + u.name
+	}
+	return "0B"
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ByteSize) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ByteSize) UnmarshalText(text []byte) error {
+	res, err := ParseByteSize(string(text))
+	if err != nil {
+		return err
+	}
+	*s = res
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts strings like "10MiB" and numbers of bytes.
+func (s *ByteSize) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var v interface{} = json.Number(data)
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		v = str
+	}
+	res, err := ToByteSizeE(v)
+	if err != nil {
+		return err
+	}
+	*s = res
+	return nil
+}
+
+// ToByteSize converts any type of value to a ByteSize, ignoring errors.
+func ToByteSize(value interface{}, converters ...ByteSizeConverter) ByteSize {
+	return std.ToByteSize(value, converters...)
+}
+
+// ToByteSize is like the package-level ToByteSize but uses the options of c.
+func (c *Converter) ToByteSize(value interface{}, converters ...ByteSizeConverter) ByteSize {
+	res, _ := c.ToByteSizeE(value, converters...)
+	return res
+}
+
+// ToByteSizeOrDefault converts any type of value to a ByteSize or returns the provided default value if conversion fails.
+func ToByteSizeOrDefault(value interface{}, defaultValue ByteSize, converters ...ByteSizeConverter) ByteSize {
+	return std.ToByteSizeOrDefault(value, defaultValue, converters...)
+}
+
+// ToByteSizeOrDefault is like the package-level ToByteSizeOrDefault but uses the options of c.
+func (c *Converter) ToByteSizeOrDefault(value interface{}, defaultValue ByteSize, converters ...ByteSizeConverter) ByteSize {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToByteSizeE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToByteSizeE converts any type of value to a ByteSize or returns an error.
+// It handles various types including:
+//   - ByteSize
+//   - strings made of a number and an optional unit, with optional spaces between them:
+//     SI units (kB, MB, GB, TB, PB, EB, or k, M, G, T, P, E) are powers of 1000,
+//     IEC units (KiB, MiB, GiB, TiB, PiB, EiB, or Ki, Mi, ...) powers of 1024,
+//     and B or no unit are bytes; units are case-insensitive
+//   - numbers of bytes, converted like ToUint64E does
+//
+// Numbers may have a fraction or an exponent, like "1.5GB" or "1e3KiB"; fractional bytes are
+// rounded with the rounding mode of the converter. Negative sizes and sizes beyond
+// math.MaxUint64 bytes are reported as ErrOverflow.
+//
+// Example:
+//
+//	size, err := ToByteSizeE("1.5 GiB")
+//	fmt.Println(uint64(size), size) // Output: 1610612736 1.5GiB
+func ToByteSizeE(value interface{}, converters ...ByteSizeConverter) (ByteSize, error) {
+	return std.ToByteSizeE(value, converters...)
+}
+
+// ToByteSizeE is like the package-level ToByteSizeE but uses the options of c.
+func (c *Converter) ToByteSizeE(value interface{}, converters ...ByteSizeConverter) (ByteSize, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[ByteSize](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, byteSizeType)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n := i.(type) {
+	case ByteSize:
+		return n, nil
+	case string:
+		return c.parseByteSize(value, n)
+	case []byte:
+		return c.parseByteSize(value, string(n))
+	}
+
+	res, err := c.ToUint64E(i)
+	if err != nil {
+		return 0, err
+	}
+	return ByteSize(res), nil
+}
+
+// parseByteSize parses the size s of value.
+func (c *Converter) parseByteSize(value interface{}, s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		if c.opts.strict {
+			return 0, newError(value, byteSizeType, ErrSyntax)
+		}
+		return 0, c.emptyString(byteSizeType)
+	}
+
+	end := numberPrefix(s)
+	unit, ok := byteSizeSuffixes[strings.ToLower(strings.TrimSpace(s[end:]))]
+	if end == 0 || !ok {
+		return 0, newError(value, byteSizeType, ErrSyntax)
+	}
+	r, ok := new(big.Rat).SetString(s[:end])
+	if !ok {
+		return 0, newError(value, byteSizeType, ErrSyntax)
+	}
+	if r.Sign() < 0 {
+		return 0, newError(value, byteSizeType, ErrOverflow)
+	}
+
+	n, err := c.roundRat(value, r.Mul(r, new(big.Rat).SetUint64(uint64(unit))), byteSizeType)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsUint64() {
+		return 0, newError(value, byteSizeType, ErrOverflow)
+	}
+	return ByteSize(n.Uint64()), nil
+}
+
+// numberPrefix returns the length of the decimal number at the start of s,
+// with an optional sign, fraction and exponent, like "-1.5e3" in "-1.5e3KiB".
+func numberPrefix(s string) int {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(s) && (isDigit(s[i]) || s[i] == '.'); i++ {
+		if s[i] != '.' {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	// An exponent needs digits, so that the E of "1EB" is read as a unit.
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+// isDigit reports whether b is an ASCII digit.
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
@@ -0,0 +1,159 @@
+package convert
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToByteSizeE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected ByteSize
+		err      error
+	}{
+		{"512", 512, nil},
+		{"512B", 512, nil},
+		{"10MiB", 10 * MiB, nil},
+		{"10 mib", 10 * MiB, nil},
+		{"1.5GB", 1500 * MB, nil},
+		{"1.5 GiB", 1536 * MiB, nil},
+		{"64k", 64 * KB, nil},
+		{"2Ki", 2 * KiB, nil},
+		{"1e3KiB", 1000 * KiB, nil},
+		{"1EB", EB, nil},
+		{"15EiB", 15 * EiB, nil},
+		{".5kB", 500, nil},
+		{" 3 TB ", 3 * TB, nil},
+		{[]byte("1KB"), KB, nil},
+		{"1.0005kB", 1000, nil},
+		{"", 0, nil},
+		{42, 42, nil},
+		{uint64(math.MaxUint64), ByteSize(math.MaxUint64), nil},
+		{2.9, 2, nil},
+		{ByteSize(7), 7, nil},
+		{ToPtr(MiB), MiB, nil},
+		{json.Number("1024"), KiB, nil},
+		{nil, 0, nil},
+		{"16EiB", 0, ErrOverflow},
+		{"-1KB", 0, ErrOverflow},
+		{-1, 0, ErrOverflow},
+		{"10XB", 0, ErrSyntax},
+		{"MB", 0, ErrSyntax},
+		{"1..5MB", 0, ErrSyntax},
+		{"abc", 0, ErrSyntax},
+	}
+
+	for _, test := range tests {
+		res, err := ToByteSizeE(test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %#v", test.input)
+			continue
+		}
+		if assert.NoError(t, err, "input %#v", test.input) {
+			assert.Equal(t, test.expected, res, "input %#v", test.input)
+		}
+	}
+
+	assert.Equal(t, MiB, ToByteSizeOrDefault("x", MiB))
+	assert.Equal(t, ByteSize(1001), New(WithRounding(RoundCeil)).ToByteSize("1.0005kB"))
+	_, err := Strict().ToByteSizeE("1.0005kB")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = Strict().ToByteSizeE("")
+	assert.ErrorIs(t, err, ErrSyntax)
+	_, err = New(WithEmptyStringAsZero(false)).ToByteSizeE("")
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	size, err := ParseByteSize("4GiB")
+	assert.NoError(t, err)
+	assert.Equal(t, 4*GiB, size)
+}
+
+func TestByteSizeString(t *testing.T) {
+	tests := []struct {
+		input    ByteSize
+		expected string
+	}{
+		{0, "0B"},
+		{1, "1B"},
+		{1023, "1023B"},
+		{KiB, "1KiB"},
+		{KB, "1kB"},
+		{1536, "1.5KiB"},
+		{10 * MiB, "10MiB"},
+		{1500 * MB, "1.5GB"},
+		{1250 * KB, "1.25MB"},
+		{1234567, "1234567B"},
+		{2048 * KB, "2000KiB"},
+		{ByteSize(math.MaxUint64), "18446744073709551615B"},
+		{15 * EiB, "15EiB"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.input.String(), "size %d", uint64(test.input))
+		parsed, err := ParseByteSize(test.input.String())
+		assert.NoError(t, err)
+		assert.Equal(t, test.input, parsed)
+	}
+}
+
+func TestByteSizeMarshaling(t *testing.T) {
+	var cfg struct {
+		MaxBody ByteSize `json:"max_body"`
+		Cache   ByteSize `json:"cache"`
+	}
+	err := json.Unmarshal([]byte(`{"max_body": "10MiB", "cache": 4096}`), &cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*MiB, cfg.MaxBody)
+	assert.Equal(t, 4*KiB, cfg.Cache)
+
+	b, err := json.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"max_body": "10MiB", "cache": "4KiB"}`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"cache": "lots"}`), &cfg))
+}
+
+func TestByteSizeIntegration(t *testing.T) {
+	v, err := ToValueE("1.5GB", reflect.TypeOf(ByteSize(0)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1500*MB, v.Interface())
+	}
+
+	s, err := ToE[ByteSize]("2MiB")
+	assert.NoError(t, err)
+	assert.Equal(t, 2*MiB, s)
+
+	var cfg struct {
+		MaxBody ByteSize            `convert:"max_body"`
+		Limits  []ByteSize          `convert:"limits"`
+		Quotas  map[string]ByteSize `convert:"quotas"`
+	}
+	err = Decode(map[string]interface{}{
+		"max_body": "10MiB",
+		"limits":   []interface{}{"1k", 2048},
+		"quotas":   map[string]interface{}{"alice": "1GB"},
+	}, &cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*MiB, cfg.MaxBody)
+	assert.Equal(t, []ByteSize{KB, 2 * KiB}, cfg.Limits)
+	assert.Equal(t, map[string]ByteSize{"alice": GB}, cfg.Quotas)
+
+	sl, err := ToSliceByteSizeE([]string{"1MB", "1MiB"})
+	assert.NoError(t, err)
+	assert.Equal(t, []ByteSize{MB, MiB}, sl)
+	_, err = ToSliceByteSizeE([]interface{}{"1MB", "huge"})
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	m, err := ToMapStringByteSizeE(`{"a": "1KiB", "b": 10}`)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]ByteSize{"a": KiB, "b": 10}, m)
+
+	assert.Equal(t, "10MiB", ToString(10*MiB))
+	assert.Equal(t, "10MiB", New(WithNumberGrouping(',')).ToString(10*MiB))
+	assert.Equal(t, uint64(1024), ToUint64(KiB))
+	assert.Equal(t, int64(1000), ToInt64(KB))
+}
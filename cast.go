@@ -0,0 +1,156 @@
+package convert
+
+import (
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// float is a constraint that permits any floating-point type.
+type float interface {
+	~float32 | ~float64
+}
+
+// number is a constraint that permits any integer or floating-point type.
+type number interface {
+	integer | float
+}
+
+// Cast converts the number v to the type To, ignoring errors.
+// It returns 0 when CastE reports an error.
+func Cast[To, From number](v From) To {
+	res, _ := CastE[To](v)
+	return res
+}
+
+// CastE converts the number v to the type To, reporting values that To cannot hold exactly.
+// Unlike the other converters, it is resolved at compile time: it uses neither interfaces
+// nor reflection, except to build the returned error, which is a *ConversionError like the
+// ones of ToInt8E or ToUint32E:
+//   - ErrOverflow for values beyond the range of To, and for NaN and infinities converted to an integer type
+//   - ErrPrecisionLoss for floats with a fractional part converted to an integer type, and
+//     integers beyond the mantissa of a float type
+//
+// Like ToFloat32E, float64 values are rounded to the nearest float32; only values beyond
+// the float32 range are reported.
+//
+// Example:
+//
+//	b, err := convert.CastE[uint8](300)        // 0, ErrOverflow
+//	n, err := convert.CastE[int](2.5)          // 0, ErrPrecisionLoss
+//	f, err := convert.CastE[float32](int64(7)) // 7, nil
+//	g, err := convert.CastE[float32](0.1)      // 0.1, nil
+func CastE[To, From number](v From) (To, error) {
+	switch {
+	case !isFloat[From]() && !isFloat[To]():
+		res := To(v)
+		if From(res) != v || (v < 0) != (res < 0) {
+			return 0, castOverflow[To](v)
+		}
+		return res, nil
+	case !isFloat[From]():
+		abs := uint64(v)
+		if v < 0 {
+			abs = uint64(-int64(v))
+		}
+		mantissa := 53
+		if isFloat32[To]() {
+			mantissa = 24
+		}
+		if !exactMantissa(abs, mantissa) {
+			return 0, castError[To](v, ErrPrecisionLoss)
+		}
+		return To(v), nil
+	case isFloat[To]():
+		f := float64(v)
+		res := To(f)
+		if math.IsInf(float64(res), 0) && !math.IsInf(f, 0) {
+			return 0, castOverflow[To](v)
+		}
+		return res, nil
+	default:
+		f := float64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, castOverflow[To](v)
+		}
+		lo, hi := castBounds[To]()
+		if f < lo || f >= hi {
+			return 0, castOverflow[To](v)
+		}
+		if f != math.Trunc(f) {
+			return 0, castError[To](v, ErrPrecisionLoss)
+		}
+		return To(f), nil
+	}
+}
+
+// CastSlice converts the numbers of s to the type To, ignoring errors.
+// It returns nil when CastSliceE reports an error.
+func CastSlice[To, From number](s []From) []To {
+	res, _ := CastSliceE[To](s)
+	return res
+}
+
+// CastSliceE converts the numbers of s to the type To like CastE does, into a new slice.
+// The error of the first number that To cannot hold exactly has its index as path, like "[2]".
+// A nil slice gives a nil slice.
+//
+// Example:
+//
+//	ports, err := convert.CastSliceE[uint16]([]int{80, 443, 70000}) // nil, "[2]" ErrOverflow
+func CastSliceE[To, From number](s []From) ([]To, error) {
+	if s == nil {
+		return nil, nil
+	}
+	res := make([]To, len(s))
+	for i, v := range s {
+		n, err := CastE[To](v)
+		if err != nil {
+			return nil, pathError(err, indexPath(i), s, reflect.TypeFor[[]To]())
+		}
+		res[i] = n
+	}
+	return res, nil
+}
+
+// castError returns the error of CastE for v and the type To.
+func castError[To, From number](v From, cause error) error {
+	return newError(v, reflect.TypeFor[To](), cause)
+}
+
+// castOverflow returns the ErrOverflow error of CastE for v and the type To, like the
+// safe* helpers do, with the direction of v. NaN has no direction.
+func castOverflow[To, From number](v From) error {
+	to := reflect.TypeFor[To]()
+	switch {
+	case v != v:
+		return nanError(v, to)
+	case v < 0:
+		return overflowError(v, to, -1)
+	default:
+		return overflowError(v, to, 1)
+	}
+}
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T number]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+// isFloat32 reports whether the floating-point type T has the precision of float32.
+func isFloat32[T number]() bool {
+	f := float64(1<<24 + 1)
+	return float64(T(f)) != f
+}
+
+// castBounds returns the smallest value of the integer type T and the power of two
+// just above its largest value, like integerBounds does for a reflect.Type.
+func castBounds[T number]() (lo, hi float64) {
+	var zero T
+	size := int(unsafe.Sizeof(zero)) * 8
+	if zero-1 > 0 {
+		return 0, math.Ldexp(1, size)
+	}
+	return -math.Ldexp(1, size-1), math.Ldexp(1, size-1)
+}
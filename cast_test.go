@@ -0,0 +1,109 @@
+package convert
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCastE(t *testing.T) {
+	tests := []struct {
+		name     string
+		cast     func() (interface{}, error)
+		expected interface{}
+		err      error
+	}{
+		{"int to uint8", func() (interface{}, error) { return CastE[uint8](255) }, uint8(255), nil},
+		{"int to uint8 overflow", func() (interface{}, error) { return CastE[uint8](256) }, uint8(0), ErrOverflow},
+		{"negative to uint", func() (interface{}, error) { return CastE[uint](-1) }, uint(0), ErrOverflow},
+		{"int8 min", func() (interface{}, error) { return CastE[int8](int64(-128)) }, int8(-128), nil},
+		{"int8 below min", func() (interface{}, error) { return CastE[int8](int64(-129)) }, int8(0), ErrOverflow},
+		{"max uint64 to int64", func() (interface{}, error) { return CastE[int64](uint64(math.MaxUint64)) }, int64(0), ErrOverflow},
+		{"max int64 to uint64", func() (interface{}, error) { return CastE[uint64](int64(math.MaxInt64)) }, uint64(math.MaxInt64), nil},
+		{"named types", func() (interface{}, error) { return CastE[namedPort](uint8(80)) }, namedPort(80), nil},
+		{"int to float32", func() (interface{}, error) { return CastE[float32](1 << 24) }, float32(1 << 24), nil},
+		{"int to float32 inexact", func() (interface{}, error) { return CastE[float32](1<<24 + 1) }, float32(0), ErrPrecisionLoss},
+		{"min int64 to float64", func() (interface{}, error) { return CastE[float64](int64(math.MinInt64)) }, float64(math.MinInt64), nil},
+		{"uint64 to float64 inexact", func() (interface{}, error) { return CastE[float64](uint64(1<<53 + 1)) }, float64(0), ErrPrecisionLoss},
+		{"float to int", func() (interface{}, error) { return CastE[int](-42.0) }, -42, nil},
+		{"float fraction to int", func() (interface{}, error) { return CastE[int](2.5) }, 0, ErrPrecisionLoss},
+		{"float to uint8 overflow", func() (interface{}, error) { return CastE[uint8](256.0) }, uint8(0), ErrOverflow},
+		{"float to int64 bound", func() (interface{}, error) { return CastE[int64](9223372036854775807.0) }, int64(0), ErrOverflow},
+		{"NaN to int", func() (interface{}, error) { return CastE[int](math.NaN()) }, 0, ErrOverflow},
+		{"infinity to uint", func() (interface{}, error) { return CastE[uint](math.Inf(1)) }, uint(0), ErrOverflow},
+		{"float64 to float32", func() (interface{}, error) { return CastE[float32](0.5) }, float32(0.5), nil},
+		{"float64 to float32 rounded", func() (interface{}, error) { return CastE[float32](0.1) }, float32(0.1), nil},
+		{"float64 to float32 overflow", func() (interface{}, error) { return CastE[float32](1e300) }, float32(0), ErrOverflow},
+		{"infinity to float32", func() (interface{}, error) { return CastE[float32](math.Inf(-1)) }, float32(math.Inf(-1)), nil},
+		{"float32 to float64", func() (interface{}, error) { return CastE[float64](float32(0.1)) }, float64(float32(0.1)), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.cast()
+			assert.Equal(t, tt.expected, res)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCastError(t *testing.T) {
+	_, castErr := CastE[int8](int64(300))
+	_, safeErr := safeInt8(int64(300), 300)
+	assert.Equal(t, safeErr.Error(), castErr.Error())
+
+	var convErr *ConversionError
+	assert.True(t, errors.As(castErr, &convErr))
+	assert.Equal(t, int64(300), convErr.Value)
+
+	_, err := CastE[uint8](-1)
+	assert.Equal(t, -1, overflowSign(err))
+	_, err = CastE[int16](uint64(math.MaxUint64))
+	assert.Equal(t, 1, overflowSign(err))
+	_, err = CastE[int32](math.Inf(-1))
+	assert.Equal(t, -1, overflowSign(err))
+	_, err = CastE[float32](-1e300)
+	assert.Equal(t, -1, overflowSign(err))
+	_, err = CastE[int](math.NaN())
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.True(t, isNaNOverflow(err))
+
+	f, err := CastE[float64](math.NaN())
+	assert.NoError(t, err)
+	assert.True(t, math.IsNaN(f))
+}
+
+func TestCast(t *testing.T) {
+	assert.Equal(t, uint16(8080), Cast[uint16](8080))
+	assert.Equal(t, uint16(0), Cast[uint16](70000))
+	assert.Equal(t, int32(7), Cast[int32](7.0))
+	assert.Equal(t, float32(0.1), Cast[float32](0.1))
+	assert.Equal(t, ToFloat32(0.1), Cast[float32](0.1))
+}
+
+func TestCastSliceE(t *testing.T) {
+	res, err := CastSliceE[uint16]([]int{80, 443, 8080})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{80, 443, 8080}, res)
+
+	res, err = CastSliceE[uint16]([]int{80, 443, 70000})
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrOverflow)
+	var convErr *ConversionError
+	assert.True(t, errors.As(err, &convErr))
+	assert.Equal(t, "[2]", convErr.Path)
+
+	res, err = CastSliceE[uint16]([]int(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	floats := CastSlice[float64]([]int32{1, -2, 3})
+	assert.Equal(t, []float64{1, -2, 3}, floats)
+	assert.Nil(t, CastSlice[int]([]float64{1, 1.5}))
+}
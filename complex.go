@@ -0,0 +1,264 @@
+package convert
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// Complex64Converter is a function type for custom complex64 conversion.
+// It takes any value and returns a pointer to a complex64 if conversion succeeds, or nil if it fails.
+type Complex64Converter func(value interface{}) *complex64
+
+// Complex128Converter is a function type for custom complex128 conversion.
+// It takes any value and returns a pointer to a complex128 if conversion succeeds, or nil if it fails.
+type Complex128Converter func(value interface{}) *complex128
+
+// ToComplex128 converts any type of value to complex128, ignoring errors.
+func ToComplex128(value interface{}, converters ...Complex128Converter) complex128 {
+	return std.ToComplex128(value, converters...)
+}
+
+// ToComplex128 is like the package-level ToComplex128 but uses the options of c.
+func (c *Converter) ToComplex128(value interface{}, converters ...Complex128Converter) complex128 {
+	res, _ := c.ToComplex128E(value, converters...)
+	return res
+}
+
+// ToComplex128OrDefault converts any type of value to complex128 or returns the provided default value if conversion fails.
+func ToComplex128OrDefault(value interface{}, defaultValue complex128, converters ...Complex128Converter) complex128 {
+	return std.ToComplex128OrDefault(value, defaultValue, converters...)
+}
+
+// ToComplex128OrDefault is like the package-level ToComplex128OrDefault but uses the options of c.
+func (c *Converter) ToComplex128OrDefault(value interface{}, defaultValue complex128, converters ...Complex128Converter) complex128 {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToComplex128E(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToComplex128E converts any type of value to complex128 or returns an error.
+// It handles various types including:
+//   - complex types (complex64, complex128)
+//   - integer, unsigned integer and float types, *big.Int, *big.Float and *big.Rat,
+//     giving a complex number with a zero imaginary part
+//   - strings as strconv.ParseComplex accepts them, like "3+4i", "(1.5-2i)", "2i" or "7"
+//   - pairs of real and imaginary parts: arrays or slices of two numbers, like [2]float64{3, 4}
+//   - maps with string keys "re" and "im", like map[string]float64{"re": 3, "im": 4};
+//     a missing part is zero
+//   - bool
+//
+// Parts beyond the float64 range are reported as ErrOverflow.
+//
+// Example:
+//
+//	z, err := ToComplex128E("3+4i")
+//	fmt.Println(cmplx.Abs(z)) // Output: 5
+func ToComplex128E(value interface{}, converters ...Complex128Converter) (complex128, error) {
+	return std.ToComplex128E(value, converters...)
+}
+
+// ToComplex128E is like the package-level ToComplex128E but uses the options of c.
+func (c *Converter) ToComplex128E(value interface{}, converters ...Complex128Converter) (complex128, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[complex128](value); ok {
+		return res, err
+	}
+
+	to := reflect.TypeFor[complex128]()
+	i, err := indirectValue(value, to)
+	if err != nil {
+		return 0, err
+	}
+	i = basicValue(i)
+	if err := c.strictNumber(i, to); err != nil {
+		return 0, err
+	}
+
+	return c.toComplex(value, i, to)
+}
+
+// ToComplex64 converts any type of value to complex64, ignoring errors.
+func ToComplex64(value interface{}, converters ...Complex64Converter) complex64 {
+	return std.ToComplex64(value, converters...)
+}
+
+// ToComplex64 is like the package-level ToComplex64 but uses the options of c.
+func (c *Converter) ToComplex64(value interface{}, converters ...Complex64Converter) complex64 {
+	res, _ := c.ToComplex64E(value, converters...)
+	return res
+}
+
+// ToComplex64OrDefault converts any type of value to complex64 or returns the provided default value if conversion fails.
+func ToComplex64OrDefault(value interface{}, defaultValue complex64, converters ...Complex64Converter) complex64 {
+	return std.ToComplex64OrDefault(value, defaultValue, converters...)
+}
+
+// ToComplex64OrDefault is like the package-level ToComplex64OrDefault but uses the options of c.
+func (c *Converter) ToComplex64OrDefault(value interface{}, defaultValue complex64, converters ...Complex64Converter) complex64 {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToComplex64E(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToComplex64E converts any type of value to complex64 or returns an error.
+// It accepts the same values as ToComplex128E. Parts beyond the float32 range are
+// reported as ErrOverflow, and, in strict mode or with RoundError, numeric parts
+// that float32 cannot represent exactly as ErrPrecisionLoss.
+func ToComplex64E(value interface{}, converters ...Complex64Converter) (complex64, error) {
+	return std.ToComplex64E(value, converters...)
+}
+
+// ToComplex64E is like the package-level ToComplex64E but uses the options of c.
+func (c *Converter) ToComplex64E(value interface{}, converters ...Complex64Converter) (complex64, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[complex64](value); ok {
+		return res, err
+	}
+
+	to := reflect.TypeFor[complex64]()
+	i, err := indirectValue(value, to)
+	if err != nil {
+		return 0, err
+	}
+	i = basicValue(i)
+	if err := c.strictNumber(i, to); err != nil {
+		return 0, err
+	}
+	if n, ok := i.(complex64); ok {
+		return n, nil
+	}
+
+	z, err := c.toComplex(value, i, to)
+	if err != nil {
+		return 0, err
+	}
+
+	res := complex64(z)
+	if overflowsFloat32(real(z)) || overflowsFloat32(imag(z)) {
+		return 0, newError(value, to, ErrOverflow)
+	}
+	if _, isString := i.(string); !isString && (c.opts.strict || c.opts.rounding == RoundError) &&
+		!math.IsNaN(real(z)) && !math.IsNaN(imag(z)) && complex128(res) != z {
+		return 0, newError(value, to, ErrPrecisionLoss)
+	}
+	return res, nil
+}
+
+// overflowsFloat32 reports whether the finite f is beyond the float32 range.
+func overflowsFloat32(f float64) bool {
+	return math.IsInf(float64(float32(f)), 0) && !math.IsInf(f, 0)
+}
+
+// toComplex converts the indirected basic value i to a complex number.
+// Strings are parsed with the bit size of to, and errors are reported for value and to.
+func (c *Converter) toComplex(value interface{}, i interface{}, to reflect.Type) (complex128, error) {
+	switch n := i.(type) {
+	case string:
+		if len(n) == 0 {
+			return 0, c.emptyString(to)
+		}
+		res, err := strconv.ParseComplex(n, to.Bits())
+		if err == nil {
+			return res, nil
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, newError(n, to, ErrOverflow)
+		} else if resBool, err := strconv.ParseBool(n); err == nil {
+			return c.toComplex(value, resBool, to)
+		}
+		return 0, newError(n, to, ErrSyntax)
+	case []byte:
+		return c.toComplex(value, string(n), to)
+	case complex64:
+		return complex128(n), nil
+	case complex128:
+		return n, nil
+	case int, int8, int16, int32, int64:
+		return complex(float64(reflect.ValueOf(n).Int()), 0), nil
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return complex(float64(reflect.ValueOf(n).Uint()), 0), nil
+	case float32, float64:
+		return complex(reflect.ValueOf(n).Float(), 0), nil
+	case big.Int, big.Float, big.Rat:
+		f, err := c.ToBigFloatE(value)
+		if err != nil {
+			return 0, err
+		}
+		res, _ := f.Float64()
+		if math.IsInf(res, 0) && !f.IsInf() {
+			return 0, newError(value, to, ErrOverflow)
+		}
+		return complex(res, 0), nil
+	case bool:
+		if n {
+			return 1, nil
+		}
+		return 0, nil
+	}
+
+	rv := reflect.ValueOf(i)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		if rv.Len() != 2 {
+			return 0, unsupportedError(value, to)
+		}
+		re, err := c.ToFloat64E(rv.Index(0).Interface())
+		if err != nil {
+			return 0, pathError(err, indexPath(0), value, to)
+		}
+		im, err := c.ToFloat64E(rv.Index(1).Interface())
+		if err != nil {
+			return 0, pathError(err, indexPath(1), value, to)
+		}
+		return complex(re, im), nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return 0, unsupportedError(value, to)
+		}
+		var parts [2]float64
+		found := false
+		for p, key := range []string{"re", "im"} {
+			v := rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+			if !v.IsValid() {
+				continue
+			}
+			found = true
+			f, err := c.ToFloat64E(v.Interface())
+			if err != nil {
+				return 0, pathError(err, keyPath(key), value, to)
+			}
+			parts[p] = f
+		}
+		if !found {
+			return 0, unsupportedError(value, to)
+		}
+		return complex(parts[0], parts[1]), nil
+	}
+
+	valueStr, _ := c.plainString(i)
+	res, err := c.toComplex(value, valueStr, to)
+	return res, stringFormError(err, value, to)
+}
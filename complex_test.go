@@ -0,0 +1,133 @@
+package convert
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type namedComplex complex128
+
+func TestToComplex128E(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected complex128
+		err      error
+	}{
+		{complex(3, 4), complex(3, 4), nil},
+		{complex64(complex(1.5, -2)), complex(1.5, -2), nil},
+		{namedComplex(complex(0, 1)), complex(0, 1), nil},
+		{ToPtr(complex(2, 2)), complex(2, 2), nil},
+		{7, complex(7, 0), nil},
+		{uint8(200), complex(200, 0), nil},
+		{2.5, complex(2.5, 0), nil},
+		{big.NewInt(12), complex(12, 0), nil},
+		{big.NewRat(1, 4), complex(0.25, 0), nil},
+		{"3+4i", complex(3, 4), nil},
+		{"(1.5-2i)", complex(1.5, -2), nil},
+		{"2i", complex(0, 2), nil},
+		{"-7", complex(-7, 0), nil},
+		{[]byte("1e3+1e-3i"), complex(1000, 0.001), nil},
+		{"true", complex(1, 0), nil},
+		{true, complex(1, 0), nil},
+		{"", 0, nil},
+		{nil, 0, nil},
+		{[2]float64{3, 4}, complex(3, 4), nil},
+		{[]interface{}{"1", 2}, complex(1, 2), nil},
+		{[]float32{1, 2, 3}, 0, ErrUnsupported},
+		{map[string]float64{"re": 3, "im": 4}, complex(3, 4), nil},
+		{map[string]interface{}{"im": "-1"}, complex(0, -1), nil},
+		{map[string]int{"x": 1}, 0, ErrUnsupported},
+		{map[string]interface{}{"re": "abc"}, 0, ErrSyntax},
+		{"3+4j", 0, ErrSyntax},
+		{"1e400+1i", 0, ErrOverflow},
+	}
+
+	for _, test := range tests {
+		res, err := ToComplex128E(test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %#v", test.input)
+			continue
+		}
+		if assert.NoError(t, err, "input %#v", test.input) {
+			assert.Equal(t, test.expected, res, "input %#v", test.input)
+		}
+	}
+
+	_, err := ToComplex128E([]interface{}{1, "x"})
+	var convErr *ConversionError
+	if assert.True(t, errors.As(err, &convErr)) {
+		assert.Equal(t, "[1]", convErr.Path)
+	}
+
+	assert.Equal(t, complex(1, 1), ToComplex128OrDefault("abc", complex(1, 1)))
+	assert.Equal(t, complex(1, 1), ToComplex128OrDefault(nil, complex(1, 1)))
+	assert.Equal(t, complex(0, 3), ToComplex128("3i"))
+
+	_, err = Strict().ToComplex128E(true)
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = Strict().ToComplex128E(nil)
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func TestToComplex64E(t *testing.T) {
+	res, err := ToComplex64E("1.5+2.5i")
+	assert.NoError(t, err)
+	assert.Equal(t, complex64(complex(1.5, 2.5)), res)
+
+	res, err = ToComplex64E(complex(0.1, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, complex64(complex(0.1, 0)), res)
+
+	_, err = ToComplex64E(complex(1e300, 0))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = ToComplex64E([2]float64{0, -1e40})
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = ToComplex64E("1e40i")
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	res, err = ToComplex64E(complex(math.Inf(1), 0))
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(float64(real(res)), 1))
+
+	_, err = New(WithRounding(RoundError)).ToComplex64E(complex(0.1, 0))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = New(WithRounding(RoundError)).ToComplex64E("0.1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, complex64(complex(1, 0)), ToComplex64OrDefault("x", 1))
+}
+
+func TestComplexIntegration(t *testing.T) {
+	assert.Equal(t, "(3+4i)", ToString(complex(3, 4)))
+	assert.Equal(t, "(0.1-1000000000000000000000i)", ToString(complex(0.1, -1e21)))
+	assert.Equal(t, "(0.1+0i)", ToString(complex64(complex(0.1, 0))))
+	s, err := Strict().ToStringE(complex(1, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, "(1+2i)", s)
+	assert.Equal(t, complex(3, 4), ToComplex128(ToString(complex(3, 4))))
+
+	v, err := ToValueE("1+1i", reflect.TypeOf(complex64(0)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, complex64(complex(1, 1)), v.Interface())
+	}
+	v, err = ToValueE([2]int{1, 2}, reflect.TypeOf(namedComplex(0)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, namedComplex(complex(1, 2)), v.Interface())
+	}
+
+	z, err := ToE[complex128](map[string]interface{}{"re": 1, "im": 2})
+	assert.NoError(t, err)
+	assert.Equal(t, complex(1, 2), z)
+
+	var signal struct {
+		Samples []complex128 `convert:"samples"`
+	}
+	err = Decode(map[string]interface{}{"samples": []interface{}{"1+1i", []float64{0, 2}, 3}}, &signal)
+	assert.NoError(t, err)
+	assert.Equal(t, []complex128{complex(1, 1), complex(0, 2), complex(3, 0)}, signal.Samples)
+}
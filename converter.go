@@ -0,0 +1,232 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Converter performs conversions with its own set of options.
+// The package-level functions use a default Converter; a Converter created with New
+// exposes the same functions as methods, so that different parts of a program can
+// convert values with different policies without affecting each other.
+//
+// A Converter is immutable once created and safe for concurrent use.
+//
+// Example:
+//
+//	csv := convert.New(
+//		convert.WithEmptyStringAsZero(false),
+//		convert.WithBoolStrings([]string{"x"}, []string{""}),
+//	)
+//
+//	n, err := csv.ToIntE("") // error: empty string
+//	b := csv.ToBool("x")     // true
+type Converter struct {
+	opts options
+}
+
+// options holds the settings of a Converter.
+type options struct {
+	// trueStrings and falseStrings are the lower-cased words accepted by ToBoolE.
+	trueStrings  map[string]struct{}
+	falseStrings map[string]struct{}
+
+	// emptyAsZero makes numeric converters return 0 for an empty string instead of an error.
+	emptyAsZero bool
+
+	// timeLocation is the location used to parse times without an explicit offset.
+	timeLocation *time.Location
+
+	// timeLayouts are tried in order before the default time parsing.
+	timeLayouts []string
+
+	// strict refuses lossy or surprising coercions.
+	strict bool
+
+	// rounding is the policy applied when a float is converted to an integer.
+	rounding RoundingMode
+
+	// structRecursion makes the map converters turn nested structs into maps.
+	structRecursion bool
+
+	// jsonNumbers is how numbers are decoded from JSON strings.
+	jsonNumbers JSONNumberMode
+
+	// numberFormat, when set, is the locale format of the numbers in strings.
+	numberFormat *NumberFormat
+
+	// numberStyle is how ToStringE formats numbers.
+	numberStyle numberStyle
+
+	// integerBase is the base in which integers are parsed from strings, 0 for Go prefixes.
+	integerBase int
+
+	// saturate makes the integer converters clamp out-of-range values instead of failing.
+	saturate bool
+
+	// fractions makes the number converters parse percentages and fractions in strings.
+	fractions bool
+
+	// unixTime makes the time converters read and write numbers as Unix timestamps in unixUnit.
+	unixTime bool
+	unixUnit UnixUnit
+}
+
+// Option configures a Converter.
+type Option func(*options)
+
+var (
+	defaultTrueStrings  = []string{"1", "t", "true", "y", "yes", "ok", "on"}
+	defaultFalseStrings = []string{"0", "f", "false", "n", "no", "off", ""}
+)
+
+// std is the Converter used by the package-level functions.
+var std = New()
+
+// strictStd is the Converter returned by Strict.
+var strictStd = New(WithStrict(true))
+
+// New creates a Converter with the default options modified by the given ones.
+func New(opts ...Option) *Converter {
+	c := &Converter{opts: options{
+		trueStrings:  stringSet(defaultTrueStrings),
+		falseStrings: stringSet(defaultFalseStrings),
+		emptyAsZero:  true,
+		numberStyle:  defaultNumberStyle,
+	}}
+	for _, opt := range opts {
+		opt(&c.opts)
+	}
+	return c
+}
+
+// Default returns the Converter used by the package-level functions.
+func Default() *Converter {
+	return std
+}
+
+// Strict returns a Converter with the default options in strict mode.
+// It allows strict conversions on a per-call basis:
+//
+//	n, err := convert.Strict().ToIntE("3.9") // error: precision loss
+//	n, err = convert.ToWithE[int](convert.Strict(), input)
+func Strict() *Converter {
+	return strictStd
+}
+
+// With returns a new Converter with the options of c modified by the given ones.
+// c itself is left unchanged.
+func (c *Converter) With(opts ...Option) *Converter {
+	next := &Converter{opts: c.opts}
+	next.opts.timeLayouts = append([]string(nil), c.opts.timeLayouts...)
+	for _, opt := range opts {
+		opt(&next.opts)
+	}
+	return next
+}
+
+// WithBoolStrings sets the strings accepted by ToBoolE as true and as false.
+// Comparison is case-insensitive.
+// By default, "1", "t", "true", "y", "yes", "ok" and "on" are true,
+// and "0", "f", "false", "n", "no", "off" and "" are false.
+func WithBoolStrings(trueValues, falseValues []string) Option {
+	return func(o *options) {
+		o.trueStrings = stringSet(trueValues)
+		o.falseStrings = stringSet(falseValues)
+	}
+}
+
+// WithEmptyStringAsZero sets whether the numeric converters return 0 for an empty string.
+// When disabled, an empty string is reported as an error. It is enabled by default.
+func WithEmptyStringAsZero(enabled bool) Option {
+	return func(o *options) {
+		o.emptyAsZero = enabled
+	}
+}
+
+// WithTimeLocation sets the location used to parse times that carry no explicit offset.
+// By default, the default timezone of carbon is used.
+func WithTimeLocation(loc *time.Location) Option {
+	return func(o *options) {
+		o.timeLocation = loc
+	}
+}
+
+// WithTimeLayouts sets layouts that ToTimeE tries, in order, before its default parsing.
+// Layouts use either the Go reference time ("2006-01-02") or the carbon format syntax ("Y-m-d").
+func WithTimeLayouts(layouts ...string) Option {
+	return func(o *options) {
+		o.timeLayouts = append([]string(nil), layouts...)
+	}
+}
+
+// WithStrict sets whether only exact, lossless, same-category conversions succeed.
+// In strict mode:
+//   - floats with a fractional part are not truncated to integers (ErrPrecisionLoss)
+//   - integers and floats that a float type cannot represent exactly are refused (ErrPrecisionLoss)
+//   - booleans and numbers are not converted to each other (ErrUnsupported)
+//   - empty strings are not converted to zero (ErrSyntax), whatever WithEmptyStringAsZero says
+//   - nil is not converted to a zero value (ErrNil)
+//   - ToStringE does not fall back to fmt.Sprintf("%v") (ErrUnsupported)
+//
+// It is disabled by default.
+func WithStrict(enabled bool) Option {
+	return func(o *options) {
+		o.strict = enabled
+	}
+}
+
+// WithRounding sets the policy applied when a float, or a string holding a float,
+// is converted to an integer. The default is RoundTruncate.
+// Whatever the mode, NaN, infinities and values outside the range of the target type
+// are reported as ErrOverflow. In strict mode, fractional floats are always refused.
+func WithRounding(mode RoundingMode) Option {
+	return func(o *options) {
+		o.rounding = mode
+	}
+}
+
+// WithStructRecursion sets whether the map converters also turn the nested structs
+// of a struct into maps, including structs behind pointers and inside slices, arrays and maps.
+// When disabled, which is the default, nested structs are kept as values.
+func WithStructRecursion(enabled bool) Option {
+	return func(o *options) {
+		o.structRecursion = enabled
+	}
+}
+
+// WithJSONNumbers sets how ToMapStringInterfaceE and ToSliceInterfaceE decode the numbers
+// of JSON strings. The default, JSONNumberFloat64, rounds integers beyond 2^53;
+// JSONNumberUseNumber and JSONNumberInt64 keep them exact.
+func WithJSONNumbers(mode JSONNumberMode) Option {
+	return func(o *options) {
+		o.jsonNumbers = mode
+	}
+}
+
+// stringSet returns the lower-cased set of the given strings.
+func stringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[strings.ToLower(v)] = struct{}{}
+	}
+	return set
+}
+
+// emptyString returns the error for an empty string converted to the given numeric type,
+// or nil if empty strings are converted to zero.
+func (c *Converter) emptyString(to reflect.Type) error {
+	if c.opts.emptyAsZero {
+		return nil
+	}
+	return newError("", to, ErrSyntax)
+}
+
+// timezone returns the carbon timezone arguments matching the time location option.
+func (c *Converter) timezone() []string {
+	if c.opts.timeLocation == nil {
+		return nil
+	}
+	return []string{c.opts.timeLocation.String()}
+}
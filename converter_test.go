@@ -0,0 +1,121 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	c := New()
+
+	assert.Equal(t, 42, c.ToInt("42"))
+	assert.True(t, c.ToBool("yes"))
+	assert.Equal(t, "3.5", c.ToString(3.5))
+}
+
+func TestDefault(t *testing.T) {
+	assert.Same(t, std, Default())
+}
+
+func TestWithBoolStrings(t *testing.T) {
+	c := New(WithBoolStrings([]string{"X", "oui"}, []string{"", "non"}))
+
+	tests := []struct {
+		input    interface{}
+		expected bool
+		hasError bool
+	}{
+		{"x", true, false},
+		{"OUI", true, false},
+		{"non", false, false},
+		{"", false, false},
+		{"true", false, true},
+		{1, true, false},
+	}
+
+	for _, tt := range tests {
+		result, err := c.ToBoolE(tt.input)
+		assert.Equal(t, tt.hasError, err != nil, "input %v", tt.input)
+		assert.Equal(t, tt.expected, result, "input %v", tt.input)
+	}
+
+	// The default converter is not affected
+	assert.True(t, ToBool("true"))
+	assert.False(t, ToBool("x"))
+}
+
+func TestWithEmptyStringAsZero(t *testing.T) {
+	c := New(WithEmptyStringAsZero(false))
+
+	_, err := c.ToIntE("")
+	assert.Error(t, err)
+	_, err = c.ToUint16E("")
+	assert.Error(t, err)
+	_, err = c.ToFloat64E("")
+	assert.Error(t, err)
+	_, err = c.ToSliceIntE([]interface{}{"1", ""})
+	assert.Error(t, err)
+
+	n, err := ToIntE("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestWithTimeLayouts(t *testing.T) {
+	c := New(WithTimeLayouts("02/01/2006", "d.m.Y"))
+
+	tm, err := c.ToTimeE("02/07/2022")
+	assert.NoError(t, err)
+	assert.True(t, tm.Equal(nowDate), tm)
+
+	tm, err = c.ToTimeE("02.07.2022")
+	assert.NoError(t, err)
+	assert.True(t, tm.Equal(nowDate), tm)
+
+	// Default parsing is still used when no layout matches
+	tm, err = c.ToTimeE("2022-07-02")
+	assert.NoError(t, err)
+	assert.True(t, tm.Equal(nowDate), tm)
+}
+
+func TestWithTimeLocation(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+
+	c := New(WithTimeLocation(paris))
+	tm, err := c.ToTimeE("2022-07-02 11:45:02")
+	assert.NoError(t, err)
+	assert.True(t, tm.Equal(nowDateTime.Add(-2*time.Hour)), tm)
+
+	assert.Equal(t, "2022-07-02 13:45:02", c.ToTimeString(nowDateTime, time.DateTime))
+}
+
+func TestConverterWith(t *testing.T) {
+	base := New(WithEmptyStringAsZero(false))
+	derived := base.With(WithBoolStrings([]string{"x"}, nil))
+
+	_, err := derived.ToIntE("")
+	assert.Error(t, err)
+	assert.True(t, derived.ToBool("x"))
+
+	_, err = base.ToBoolE("x")
+	assert.Error(t, err)
+}
+
+func TestToWithE(t *testing.T) {
+	c := New(WithEmptyStringAsZero(false))
+
+	_, err := ToWithE[int](c, "")
+	assert.Error(t, err)
+
+	n, err := ToWithE[int](c, "7")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, n)
+
+	assert.Equal(t, 42, ToWithOrDefault(c, "", 42))
+	assert.Equal(t, 7, ToWith[int](c, "7"))
+}
@@ -0,0 +1,529 @@
+package convert
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalExponent bounds the exponent accepted by ParseDecimal, so that inputs like
+// "1e999999999" cannot allocate huge coefficients.
+const maxDecimalExponent = 100000
+
+var decimalType = reflect.TypeFor[Decimal]()
+
+// Decimal is an exact fixed-point decimal number: an arbitrary-precision integer
+// coefficient and a scale, the number of digits after the decimal point.
+// Its value is coefficient × 10^-scale, so "1.50" has the coefficient 150 and the scale 2.
+// The zero Decimal is 0.
+//
+// Decimal values are immutable: the arithmetic methods return new values.
+// Compare them with Cmp or Equal rather than ==, since "1.5" and "1.50" are equal
+// but have different scales.
+//
+// Decimal implements encoding.TextMarshaler and encoding.TextUnmarshaler,
+// json.Marshaler and json.Unmarshaler (as a JSON number, or a string when decoding),
+// and driver.Valuer and sql.Scanner (as a string).
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// DecimalConverter is a function type for custom Decimal conversion.
+// It takes any value and returns a pointer to a Decimal if conversion succeeds, or nil if it fails.
+type DecimalConverter func(value interface{}) *Decimal
+
+// NewDecimal returns the Decimal coef × 10^-scale, ignoring errors.
+// It returns the zero Decimal when NewDecimalE reports an error.
+func NewDecimal(coef int64, scale int32) Decimal {
+	res, _ := NewDecimalE(coef, scale)
+	return res
+}
+
+// NewDecimalE returns the Decimal coef × 10^-scale. A negative scale gives an integer:
+// NewDecimalE(5, -2) is 500, with the scale 0. Like ParseDecimal, it accepts scales
+// down to -100000, and reports lower ones as ErrOverflow rather than allocating
+// a huge coefficient.
+func NewDecimalE(coef int64, scale int32) (Decimal, error) {
+	return NewDecimalFromBigIntE(big.NewInt(coef), scale)
+}
+
+// NewDecimalFromBigInt returns the Decimal coef × 10^-scale, ignoring errors.
+// It returns the zero Decimal when NewDecimalFromBigIntE reports an error.
+func NewDecimalFromBigInt(coef *big.Int, scale int32) Decimal {
+	res, _ := NewDecimalFromBigIntE(coef, scale)
+	return res
+}
+
+// NewDecimalFromBigIntE returns the Decimal coef × 10^-scale like NewDecimalE. coef is copied.
+func NewDecimalFromBigIntE(coef *big.Int, scale int32) (Decimal, error) {
+	if scale < -maxDecimalExponent {
+		return Decimal{}, scaleError(coef, int64(scale))
+	}
+	res := new(big.Int).Set(coef)
+	if scale < 0 {
+		res.Mul(res, pow10(-int(scale)))
+		scale = 0
+	}
+	return Decimal{coef: res, scale: scale}, nil
+}
+
+// scaleError returns the error for a scale that a Decimal cannot have.
+func scaleError(value interface{}, scale int64) error {
+	return newError(value, decimalType, fmt.Errorf("%w: scale %d", ErrOverflow, scale))
+}
+
+// ParseDecimal parses s as a decimal number: an optional sign, digits with an optional
+// decimal point, and an optional exponent, like "-12.50" or "1.5e-3".
+// The scale of the result is the number of digits after the decimal point, adjusted
+// by the exponent: "1.50" has the scale 2 and "1.5e3" the scale 0.
+func ParseDecimal(s string) (Decimal, error) {
+	d, ok := parseDecimal(s)
+	if !ok {
+		return Decimal{}, newError(s, decimalType, ErrSyntax)
+	}
+	return d, nil
+}
+
+// parseDecimal parses s as ParseDecimal does.
+func parseDecimal(s string) (Decimal, bool) {
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return Decimal{}, false
+		}
+		mantissa, exponent = s[:i], e
+	}
+
+	sign := ""
+	if len(mantissa) > 0 && (mantissa[0] == '+' || mantissa[0] == '-') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, false
+	}
+
+	coef, _ := new(big.Int).SetString(sign+digits, 10)
+	scale := len(fracPart) - exponent
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	if scale > math.MaxInt32 {
+		return Decimal{}, false
+	}
+	return Decimal{coef: coef, scale: int32(scale)}, true
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// coefficient returns the coefficient of d, which must not be modified.
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// Coefficient returns a copy of the coefficient of d.
+func (d Decimal) Coefficient() *big.Int {
+	return new(big.Int).Set(d.coefficient())
+}
+
+// Scale returns the number of digits of d after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// String returns d in plain notation with exactly Scale digits after the decimal point,
+// like "-12.50".
+func (d Decimal) String() string {
+	digits := d.coefficient().String()
+	neg := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		n := len(digits) - int(d.scale)
+		digits = digits[:n] + "." + digits[n:]
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Rat returns d as a *big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.coefficient(), pow10(int(d.scale)))
+}
+
+// Float64 returns the float64 nearest to d, and whether it is exact.
+func (d Decimal) Float64() (float64, bool) {
+	return d.Rat().Float64()
+}
+
+// rescale returns the coefficients of a and b with the same, largest, scale.
+func rescale(a, b Decimal) (*big.Int, *big.Int, int32) {
+	ca, cb := a.coefficient(), b.coefficient()
+	switch {
+	case a.scale < b.scale:
+		return new(big.Int).Mul(ca, pow10(int(b.scale-a.scale))), cb, b.scale
+	case a.scale > b.scale:
+		return ca, new(big.Int).Mul(cb, pow10(int(a.scale-b.scale))), a.scale
+	default:
+		return ca, cb, a.scale
+	}
+}
+
+// Cmp compares d and other and returns -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := rescale(d, other)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and other are the same number, whatever their scales.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.coefficient()), scale: d.scale}
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.coefficient()), scale: d.scale}
+}
+
+// Add returns d + other, with the larger of their scales.
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, scale := rescale(d, other)
+	return Decimal{coef: new(big.Int).Add(a, b), scale: scale}
+}
+
+// Sub returns d - other, with the larger of their scales.
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, scale := rescale(d, other)
+	return Decimal{coef: new(big.Int).Sub(a, b), scale: scale}
+}
+
+// Mul returns d × other, with the sum of their scales, ignoring errors.
+// It returns the zero Decimal when MulE reports an error.
+func (d Decimal) Mul(other Decimal) Decimal {
+	res, _ := d.MulE(other)
+	return res
+}
+
+// MulE returns d × other, with the sum of their scales. A sum beyond math.MaxInt32,
+// which needs scales beyond what ParseDecimal and the converters produce,
+// is reported as ErrOverflow.
+func (d Decimal) MulE(other Decimal) (Decimal, error) {
+	scale := int64(d.scale) + int64(other.scale)
+	if scale > math.MaxInt32 {
+		return Decimal{}, scaleError(d, scale)
+	}
+	return Decimal{coef: new(big.Int).Mul(d.coefficient(), other.coefficient()), scale: int32(scale)}, nil
+}
+
+// Quo returns d / other rounded to the given scale with the given rounding mode.
+// Division by zero is reported as ErrDivisionByZero; with RoundError,
+// an inexact quotient is reported as ErrPrecisionLoss.
+func (d Decimal) Quo(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, newError(d, decimalType, ErrDivisionByZero)
+	}
+	scale = max(scale, 0)
+
+	// d / other = (cd × 10^(scale + so - sd)) / co × 10^-scale
+	num, den := d.Coefficient(), other.Coefficient()
+	if shift := int(scale) + int(other.scale) - int(d.scale); shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	q, exact := quoRound(num, den, mode)
+	if !exact && mode == RoundError {
+		return Decimal{}, newError(d, decimalType, ErrPrecisionLoss)
+	}
+	return Decimal{coef: q, scale: scale}, nil
+}
+
+// Round returns d with the given scale, rounded with the given rounding mode when digits
+// are dropped. With RoundError, dropping non-zero digits is reported as ErrPrecisionLoss.
+func (d Decimal) Round(scale int32, mode RoundingMode) (Decimal, error) {
+	scale = max(scale, 0)
+	if scale >= d.scale {
+		return Decimal{coef: new(big.Int).Mul(d.coefficient(), pow10(int(scale-d.scale))), scale: scale}, nil
+	}
+	q, exact := quoRound(d.coefficient(), pow10(int(d.scale-scale)), mode)
+	if !exact && mode == RoundError {
+		return Decimal{}, newError(d, decimalType, ErrPrecisionLoss)
+	}
+	return Decimal{coef: q, scale: scale}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	res, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. d is written as a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts JSON numbers and strings;
+// null leaves d unchanged.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return d.UnmarshalText(data)
+}
+
+// Value implements driver.Valuer. d is stored as a string, which keeps its precision.
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the values ToDecimalE accepts, except NULL;
+// use Optional[Decimal] for nullable columns.
+func (d *Decimal) Scan(src interface{}) error {
+	if src == nil {
+		return newError(src, decimalType, ErrNil)
+	}
+	res, err := ToDecimalE(src)
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
+// decimalFromRat returns r as a Decimal if its decimal expansion is finite and its scale
+// fits in an int32.
+func decimalFromRat(r *big.Rat) (Decimal, bool) {
+	den := new(big.Int).Set(r.Denom())
+	twos := int(den.TrailingZeroBits())
+	den.Rsh(den, uint(twos))
+
+	fives := 0
+	five, m := big.NewInt(5), new(big.Int)
+	for {
+		q, _ := new(big.Int).QuoRem(den, five, m)
+		if m.Sign() != 0 {
+			break
+		}
+		den, fives = q, fives+1
+	}
+	if den.Cmp(big.NewInt(1)) != 0 {
+		return Decimal{}, false
+	}
+
+	scale := max(twos, fives)
+	if scale > math.MaxInt32 {
+		return Decimal{}, false
+	}
+	coef := new(big.Int).Mul(r.Num(), new(big.Int).Quo(pow10(scale), r.Denom()))
+	return Decimal{coef: coef, scale: int32(scale)}, true
+}
+
+// ToDecimal converts any type of value to a Decimal, ignoring errors.
+func ToDecimal(value interface{}, converters ...DecimalConverter) Decimal {
+	return std.ToDecimal(value, converters...)
+}
+
+// ToDecimal is like the package-level ToDecimal but uses the options of c.
+func (c *Converter) ToDecimal(value interface{}, converters ...DecimalConverter) Decimal {
+	res, _ := c.ToDecimalE(value, converters...)
+	return res
+}
+
+// ToDecimalOrDefault converts any type of value to a Decimal or returns the provided default value if conversion fails.
+func ToDecimalOrDefault(value interface{}, defaultValue Decimal, converters ...DecimalConverter) Decimal {
+	return std.ToDecimalOrDefault(value, defaultValue, converters...)
+}
+
+// ToDecimalOrDefault is like the package-level ToDecimalOrDefault but uses the options of c.
+func (c *Converter) ToDecimalOrDefault(value interface{}, defaultValue Decimal, converters ...DecimalConverter) Decimal {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToDecimalE(value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToDecimalE converts any type of value to a Decimal or returns an error.
+// It handles various types including:
+//   - Decimal, and strings parsed with ParseDecimal, like "12.50" or "1.5e-3"
+//   - json.Number, parsed exactly
+//   - integer and unsigned integer types, and *big.Int, with the scale 0
+//   - float types, through their shortest decimal representation: 0.1 gives 0.1,
+//     not the exact binary value; use ToDecimalScaleE to choose the scale
+//   - *big.Rat and *big.Float with a finite decimal expansion
+//   - bool
+//
+// NaN and infinities are reported as ErrOverflow, and rationals like 1/3
+// as ErrPrecisionLoss.
+func ToDecimalE(value interface{}, converters ...DecimalConverter) (Decimal, error) {
+	return std.ToDecimalE(value, converters...)
+}
+
+// ToDecimalE is like the package-level ToDecimalE but uses the options of c.
+func (c *Converter) ToDecimalE(value interface{}, converters ...DecimalConverter) (Decimal, error) {
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[Decimal](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, decimalType)
+	if err != nil {
+		return Decimal{}, err
+	}
+	i = basicValue(i)
+	if i, err = c.localNumber(i, decimalType); err != nil {
+		return Decimal{}, err
+	}
+	if err := c.strictNumber(i, decimalType); err != nil {
+		return Decimal{}, err
+	}
+
+	switch n := i.(type) {
+	case string:
+		if len(n) == 0 {
+			return Decimal{}, c.emptyString(decimalType)
+		}
+		if res, ok := parseDecimal(n); ok {
+			return res, nil
+		} else if isInfString(n) || strings.EqualFold(n, "nan") {
+			return Decimal{}, newError(n, decimalType, ErrOverflow)
+		} else if resBool, err := strconv.ParseBool(n); err == nil {
+			return c.ToDecimalE(resBool)
+		} else {
+			return Decimal{}, newError(n, decimalType, ErrSyntax)
+		}
+	case big.Int:
+		return NewDecimalFromBigInt(&n, 0), nil
+	case big.Rat:
+		if res, ok := decimalFromRat(&n); ok {
+			return res, nil
+		}
+		return Decimal{}, newError(value, decimalType, ErrPrecisionLoss)
+	case big.Float:
+		if n.IsInf() {
+			return Decimal{}, newError(value, decimalType, ErrOverflow)
+		}
+		// The scale of n is the number of its binary digits after the point.
+		if int64(n.MinPrec())-int64(n.MantExp(nil)) > math.MaxInt32 {
+			return Decimal{}, newError(value, decimalType, ErrOverflow)
+		}
+		r, _ := n.Rat(nil)
+		if res, ok := decimalFromRat(r); ok {
+			return res, nil
+		}
+		return Decimal{}, newError(value, decimalType, ErrOverflow)
+	case int, int8, int16, int32, int64:
+		return NewDecimal(reflect.ValueOf(n).Int(), 0), nil
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return NewDecimalFromBigInt(new(big.Int).SetUint64(reflect.ValueOf(n).Uint()), 0), nil
+	case float32, float64:
+		f := reflect.ValueOf(n).Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return Decimal{}, newError(value, decimalType, ErrOverflow)
+		}
+		bitSize := 64
+		if _, ok := n.(float32); ok {
+			bitSize = 32
+		}
+		res, _ := parseDecimal(strconv.FormatFloat(f, 'g', -1, bitSize))
+		return res, nil
+	case bool:
+		if n {
+			return NewDecimal(1, 0), nil
+		}
+		return Decimal{}, nil
+	default:
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToDecimalE(valueStr)
+		return res, stringFormError(err, value, decimalType)
+	}
+}
+
+// ToDecimalScaleE converts any type of value to a Decimal with the given scale, rounding
+// with the rounding mode of the converter. Unlike ToDecimalE, floats are rounded from
+// their exact binary value and rationals like 1/3 are accepted.
+// With RoundError or in strict mode, dropping non-zero digits is reported as ErrPrecisionLoss.
+//
+// Example:
+//
+//	d, err := convert.New(convert.WithRounding(convert.RoundHalfEven)).ToDecimalScaleE(2.675, 2)
+//	fmt.Println(d) // Output: 2.67
+func ToDecimalScaleE(value interface{}, scale int32) (Decimal, error) {
+	return std.ToDecimalScaleE(value, scale)
+}
+
+// ToDecimalScaleE is like the package-level ToDecimalScaleE but uses the options of c.
+func (c *Converter) ToDecimalScaleE(value interface{}, scale int32) (Decimal, error) {
+	r, err := c.ToBigRatE(value)
+	if err != nil {
+		return Decimal{}, err
+	}
+	scale = max(scale, 0)
+	num := new(big.Int).Mul(r.Num(), pow10(int(scale)))
+	q, exact := quoRound(num, r.Denom(), c.opts.rounding)
+	if !exact && (c.opts.strict || c.opts.rounding == RoundError) {
+		return Decimal{}, newError(value, decimalType, ErrPrecisionLoss)
+	}
+	return Decimal{coef: q, scale: scale}, nil
+}
@@ -0,0 +1,269 @@
+package convert
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		coef  string
+		scale int32
+		str   string
+	}{
+		{"0", "0", 0, "0"},
+		{"12.50", "1250", 2, "12.50"},
+		{"-0.05", "-5", 2, "-0.05"},
+		{"+3", "3", 0, "3"},
+		{".5", "5", 1, "0.5"},
+		{"7.", "7", 0, "7"},
+		{"1.5e3", "1500", 0, "1500"},
+		{"1.5E-3", "15", 4, "0.0015"},
+		{"123456789012345678901234567890.123", "123456789012345678901234567890123", 3, "123456789012345678901234567890.123"},
+	}
+
+	for _, test := range tests {
+		d, err := ParseDecimal(test.input)
+		if assert.NoError(t, err, test.input) {
+			assert.Equal(t, test.coef, d.Coefficient().String(), test.input)
+			assert.Equal(t, test.scale, d.Scale(), test.input)
+			assert.Equal(t, test.str, d.String(), test.input)
+		}
+	}
+
+	for _, input := range []string{"", "-", "abc", "1.2.3", "1e", "0x10", "1_000", "1e999999999"} {
+		_, err := ParseDecimal(input)
+		assert.ErrorIs(t, err, ErrSyntax, input)
+	}
+
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "-1.23", NewDecimal(-123, 2).String())
+	assert.Equal(t, "50", NewDecimal(5, -1).String())
+	assert.Equal(t, int32(0), NewDecimal(5, -1).Scale())
+	assert.Equal(t, "-1200", NewDecimalFromBigInt(big.NewInt(-12), -2).String())
+	assert.Equal(t, "0", NewDecimal(1, math.MinInt32).String())
+
+	d, err := NewDecimalE(7, -3)
+	assert.NoError(t, err)
+	assert.Equal(t, "7000", d.String())
+	_, err = NewDecimalE(1, -maxDecimalExponent-1)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = NewDecimalFromBigIntE(big.NewInt(1), math.MinInt32)
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, decimalType, convErr.To)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, _ := ParseDecimal("0.1")
+	b, _ := ParseDecimal("0.20")
+
+	assert.Equal(t, "0.30", a.Add(b).String())
+	assert.Equal(t, "-0.10", a.Sub(b).String())
+	assert.Equal(t, "0.020", a.Mul(b).String())
+	assert.Equal(t, int32(math.MaxInt32), NewDecimal(1, math.MaxInt32-1).Mul(a).Scale())
+	_, err := NewDecimal(1, math.MaxInt32).MulE(a)
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.True(t, NewDecimal(1, math.MaxInt32).Mul(a).IsZero())
+	p, err := a.MulE(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.020", p.String())
+	assert.True(t, a.Add(a).Equal(b))
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, 1, b.Cmp(a.Neg()))
+	assert.True(t, Decimal{}.IsZero())
+	assert.Equal(t, -1, a.Neg().Sign())
+	assert.Equal(t, "0.1", a.Neg().Abs().String())
+
+	q, err := NewDecimal(1, 0).Quo(NewDecimal(3, 0), 4, RoundHalfEven)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3333", q.String())
+
+	q, err = NewDecimal(-2, 0).Quo(NewDecimal(-3, 1), 2, RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, "6.67", q.String())
+
+	_, err = NewDecimal(1, 0).Quo(NewDecimal(3, 0), 2, RoundError)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = NewDecimal(1, 0).Quo(Decimal{}, 2, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+
+	f, exact := b.Float64()
+	assert.Equal(t, 0.2, f)
+	assert.False(t, exact)
+	assert.Equal(t, "1/5", b.Rat().String())
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		input    string
+		scale    int32
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.675", 2, RoundHalfUp, "2.68"},
+		{"2.665", 2, RoundHalfEven, "2.66"},
+		{"-2.675", 2, RoundHalfUp, "-2.68"},
+		{"2.679", 2, RoundTruncate, "2.67"},
+		{"-2.671", 2, RoundFloor, "-2.68"},
+		{"2.671", 2, RoundCeil, "2.68"},
+		{"2.5", 4, RoundError, "2.5000"},
+		{"2.500", 1, RoundError, "2.5"},
+	}
+
+	for _, test := range tests {
+		d, _ := ParseDecimal(test.input)
+		res, err := d.Round(test.scale, test.mode)
+		if assert.NoError(t, err, test.input) {
+			assert.Equal(t, test.expected, res.String(), "%s %v", test.input, test.mode)
+		}
+	}
+
+	_, err := NewDecimal(2675, 3).Round(2, RoundError)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestDecimalMarshaling(t *testing.T) {
+	var v struct {
+		Price Decimal  `json:"price"`
+		Tax   Decimal  `json:"tax"`
+		Fee   *Decimal `json:"fee"`
+	}
+	err := json.Unmarshal([]byte(`{"price": 19.990, "tax": "1.50", "fee": null}`), &v)
+	assert.NoError(t, err)
+	assert.Equal(t, "19.990", v.Price.String())
+	assert.Equal(t, "1.50", v.Tax.String())
+	assert.Nil(t, v.Fee)
+
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"price": 19.990, "tax": 1.50, "fee": null}`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"price": "abc"}`), &v))
+
+	text, err := NewDecimal(-5, 3).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.005", string(text))
+
+	var d Decimal
+	assert.NoError(t, d.UnmarshalText([]byte("42.0")))
+	assert.Equal(t, "42.0", d.String())
+
+	dv, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "42.0", dv)
+
+	assert.NoError(t, d.Scan([]byte("3.14")))
+	assert.Equal(t, "3.14", d.String())
+	assert.NoError(t, d.Scan(int64(7)))
+	assert.Equal(t, "7", d.String())
+	assert.NoError(t, d.Scan(0.1))
+	assert.Equal(t, "0.1", d.String())
+	assert.ErrorIs(t, d.Scan(nil), ErrNil)
+
+	var o Optional[Decimal]
+	assert.NoError(t, o.Scan("9.99"))
+	assert.Equal(t, "9.99", o.V.String())
+	assert.NoError(t, o.Scan(nil))
+	assert.True(t, o.IsNull())
+}
+
+func TestToDecimalE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected string
+		err      error
+	}{
+		{"0.1", "0.1", nil},
+		{[]byte("-3.50"), "-3.50", nil},
+		{json.Number("9007199254740993.01"), "9007199254740993.01", nil},
+		{42, "42", nil},
+		{uint64(math.MaxUint64), "18446744073709551615", nil},
+		{0.1, "0.1", nil},
+		{float32(0.1), "0.1", nil},
+		{1e21, "1000000000000000000000", nil},
+		{big.NewInt(12), "12", nil},
+		{big.NewRat(3, 8), "0.375", nil},
+		{big.NewFloat(0.25), "0.25", nil},
+		{NewDecimal(150, 2), "1.50", nil},
+		{ToPtr(NewDecimal(1, 1)), "0.1", nil},
+		{sql.NullString{String: "2.5", Valid: true}, "2.5", nil},
+		{true, "1", nil},
+		{"", "0", nil},
+		{namedCelsius(36.6), "36.6", nil},
+		{"abc", "", ErrSyntax},
+		{"NaN", "", ErrOverflow},
+		{math.Inf(1), "", ErrOverflow},
+		{big.NewRat(1, 3), "", ErrPrecisionLoss},
+		{new(big.Float).SetMantExp(big.NewFloat(0.5), math.MinInt32+1), "", ErrOverflow},
+	}
+
+	for _, test := range tests {
+		res, err := ToDecimalE(test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %#v", test.input)
+			continue
+		}
+		if assert.NoError(t, err, "input %#v", test.input) {
+			assert.Equal(t, test.expected, res.String(), "input %#v", test.input)
+		}
+	}
+
+	assert.Equal(t, "1", ToDecimalOrDefault("x", NewDecimal(1, 0)).String())
+	_, err := Strict().ToDecimalE(true)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestToDecimalScaleE(t *testing.T) {
+	d, err := ToDecimalScaleE(2.675, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "2.67", d.String())
+
+	d, err = New(WithRounding(RoundHalfUp)).ToDecimalScaleE(big.NewRat(2, 3), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.667", d.String())
+
+	d, err = ToDecimalScaleE("1.5", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.500", d.String())
+
+	_, err = Strict().ToDecimalScaleE("1.005", 2)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestDecimalIntegration(t *testing.T) {
+	d, err := ToE[Decimal]("10.25")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.25", d.String())
+
+	v, err := ToValueE(3, reflect.TypeOf(Decimal{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "3", v.Interface().(Decimal).String())
+	}
+
+	var order struct {
+		Total Decimal `convert:"total"`
+	}
+	assert.NoError(t, Decode(map[string]interface{}{"total": "99.90"}, &order))
+	assert.Equal(t, "99.90", order.Total.String())
+
+	assert.Equal(t, "99.90", ToString(order.Total))
+	assert.Equal(t, 99.9, ToFloat64(order.Total))
+	assert.Equal(t, int64(99), ToInt64(order.Total))
+
+	m, err := ToMapStringInterfaceE(order)
+	assert.NoError(t, err)
+	assert.Equal(t, order.Total, m["total"])
+
+	r, err := ToBigRatE(order.Total)
+	assert.NoError(t, err)
+	assert.Equal(t, "999/10", r.String())
+}
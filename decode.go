@@ -0,0 +1,364 @@
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Decode converts src into the value pointed to by dst.
+// It is meant to turn loosely typed data, like decoded JSON, YAML or query parameters,
+// into typed values:
+//   - structs are filled from maps, each field being looked up by its name or by the
+//     name given in its `convert:"name"` tag (or `json:"name"` without a convert tag),
+//     exactly then case-insensitively, the first key in sorted order winning when several
+//     keys differ only by case; `convert:"-"` skips a field and unknown keys are ignored
+//   - the fields of embedded structs, and of struct fields tagged `convert:",inline"`,
+//     are promoted, unless the embedded field has a tag name
+//   - nested structs, pointers, slices, arrays and maps are decoded recursively
+//   - every other value is converted with ToValueE, and so with the ToXxxE functions
+//
+// On failure, the returned ConversionError holds the path of the failing field,
+// like "Items[2].Price". A destination that is not a pointer is reported as
+// ErrUnsupported, and a nil one as ErrNil.
+//
+// Example:
+//
+//	type Item struct {
+//		Name  string  `convert:"name"`
+//		Price float64 `convert:"price"`
+//	}
+//	type Order struct {
+//		ID    int               `convert:"id"`
+//		Items []Item            `convert:"items"`
+//		Tags  map[string]string `convert:"tags"`
+//	}
+//
+//	var order Order
+//	err := convert.Decode(map[string]interface{}{
+//		"id":    "42",
+//		"items": []interface{}{map[string]interface{}{"name": "pen", "price": "1.5"}},
+//		"tags":  map[string]interface{}{"env": "prod"},
+//	}, &order)
+func Decode(src interface{}, dst interface{}) error {
+	return std.Decode(src, dst)
+}
+
+// Decode is like the package-level Decode but uses the options of c.
+func (c *Converter) Decode(src interface{}, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	switch {
+	case dst == nil || rv.Kind() == reflect.Pointer && rv.IsNil():
+		return newError(dst, reflect.TypeOf(dst), ErrNil)
+	case rv.Kind() != reflect.Pointer:
+		return newError(dst, reflect.TypeOf(dst), ErrUnsupported)
+	}
+	return c.decode(src, rv.Elem())
+}
+
+// decode converts src into dst, which must be settable.
+func (c *Converter) decode(src interface{}, dst reflect.Value) error {
+	to := dst.Type()
+
+	if res, ok, err := lookupRegistry(src, to); ok {
+		if err != nil {
+			return err
+		}
+		dst.Set(registryValue(res, to))
+		return nil
+	}
+
+	if isOptional(to) {
+		res, err := c.toOptional(src, to)
+		if err != nil {
+			return err
+		}
+		dst.Set(res)
+		return nil
+	}
+
+	if src == nil {
+		dst.Set(reflect.Zero(to))
+		return nil
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(to) {
+		dst.Set(sv)
+		return nil
+	}
+
+	if to.Kind() == reflect.Pointer {
+		if sv.Kind() == reflect.Pointer && sv.IsNil() {
+			dst.Set(reflect.Zero(to))
+			return nil
+		}
+		elem := reflect.New(to.Elem())
+		if err := c.decode(src, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	if sv.Kind() == reflect.Pointer {
+		if sv.IsNil() {
+			dst.Set(reflect.Zero(to))
+			return nil
+		}
+		return c.decode(sv.Elem().Interface(), dst)
+	}
+
+	if _, ok := casters[to]; !ok && !((isTextUnmarshaler(to) || isScanner(to)) && sv.Kind() != reflect.Map) {
+		switch to.Kind() {
+		case reflect.Struct:
+			return c.decodeStruct(sv, dst)
+		case reflect.Slice:
+			return c.decodeSlice(sv, dst)
+		case reflect.Array:
+			return c.decodeArray(sv, dst)
+		case reflect.Map:
+			return c.decodeMap(sv, dst)
+		case reflect.Interface:
+			return unsupportedError(src, to)
+		}
+	}
+
+	v, err := c.ToValueE(src, to)
+	if err != nil {
+		return err
+	}
+	dst.Set(v)
+	return nil
+}
+
+// decodeStruct fills the fields of the struct dst from the map sv.
+func (c *Converter) decodeStruct(sv reflect.Value, dst reflect.Value) error {
+	if sv.Kind() != reflect.Map {
+		return unsupportedError(sv.Interface(), dst.Type())
+	}
+
+	keys := make(map[string]reflect.Value, sv.Len())
+	names := make([]string, 0, sv.Len())
+	iter := sv.MapRange()
+	for iter.Next() {
+		key, err := c.plainString(iter.Key().Interface())
+		if err != nil {
+			return pathError(err, keyPath(fmt.Sprint(iter.Key().Interface())), iter.Key().Interface(), stringType)
+		}
+		if _, ok := keys[key]; !ok {
+			names = append(names, key)
+		}
+		keys[key] = iter.Value()
+	}
+	// The case-insensitive lookup walks the keys in sorted order, so that the same key wins on every run.
+	sort.Strings(names)
+
+	for _, f := range cachedFields(dst.Type()) {
+		val, ok := keys[f.name]
+		if !ok {
+			for _, key := range names {
+				if strings.EqualFold(key, f.name) {
+					val, ok = keys[key], true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+		field := fieldByIndex(dst, f.index)
+		if err := c.decode(val.Interface(), field); err != nil {
+			return pathError(err, f.goName, val.Interface(), field.Type())
+		}
+	}
+	return nil
+}
+
+// decodeSlice fills the slice dst from the slice or array sv.
+func (c *Converter) decodeSlice(sv reflect.Value, dst reflect.Value) error {
+	to := dst.Type()
+	if sv.Kind() == reflect.String && to.Elem().Kind() == reflect.Uint8 {
+		dst.Set(reflect.ValueOf([]byte(sv.String())).Convert(to))
+		return nil
+	}
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		return unsupportedError(sv.Interface(), to)
+	}
+
+	res := reflect.MakeSlice(to, sv.Len(), sv.Len())
+	for i := 0; i < sv.Len(); i++ {
+		elem := sv.Index(i).Interface()
+		if err := c.decode(elem, res.Index(i)); err != nil {
+			return pathError(err, indexPath(i), elem, to.Elem())
+		}
+	}
+	dst.Set(res)
+	return nil
+}
+
+// decodeArray fills the array dst from the slice or array sv, which must not be longer than dst.
+func (c *Converter) decodeArray(sv reflect.Value, dst reflect.Value) error {
+	to := dst.Type()
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		return unsupportedError(sv.Interface(), to)
+	}
+	if sv.Len() > to.Len() {
+		return newError(sv.Interface(), to, ErrOverflow)
+	}
+
+	res := reflect.New(to).Elem()
+	for i := 0; i < sv.Len(); i++ {
+		elem := sv.Index(i).Interface()
+		if err := c.decode(elem, res.Index(i)); err != nil {
+			return pathError(err, indexPath(i), elem, to.Elem())
+		}
+	}
+	dst.Set(res)
+	return nil
+}
+
+// decodeMap fills the map dst from the map sv, converting both keys and values.
+func (c *Converter) decodeMap(sv reflect.Value, dst reflect.Value) error {
+	to := dst.Type()
+	if sv.Kind() != reflect.Map {
+		return unsupportedError(sv.Interface(), to)
+	}
+
+	res := reflect.MakeMapWithSize(to, sv.Len())
+	iter := sv.MapRange()
+	for iter.Next() {
+		srcKey, srcVal := iter.Key().Interface(), iter.Value().Interface()
+		segment := keyPath(fmt.Sprint(srcKey))
+
+		key := reflect.New(to.Key()).Elem()
+		if err := c.decode(srcKey, key); err != nil {
+			return pathError(err, segment, srcKey, to.Key())
+		}
+		val := reflect.New(to.Elem()).Elem()
+		if err := c.decode(srcVal, val); err != nil {
+			return pathError(err, segment, srcVal, to.Elem())
+		}
+		res.SetMapIndex(key, val)
+	}
+	dst.Set(res)
+	return nil
+}
+
+// structField describes a struct field reachable from a struct type, promoted fields included.
+type structField struct {
+	// name is the key of the field, given by its tag or its Go name.
+	name string
+	// goName is the Go name of the field, used in error paths.
+	goName string
+	// index is the index sequence of the field for reflect.Value.FieldByIndex.
+	index []int
+	// opts are the tag options following the name.
+	opts string
+}
+
+// fieldCache caches the fields of struct types.
+var fieldCache sync.Map // map[reflect.Type][]structField
+
+// cachedFields returns the fields of the struct type t.
+func cachedFields(t reflect.Type) []structField {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]structField)
+	}
+	f, _ := fieldCache.LoadOrStore(t, typeFields(t))
+	return f.([]structField)
+}
+
+// typeFields collects the exported fields of the struct type t, following the visibility
+// rules of Go: a field hides the fields of the same name that are embedded deeper.
+func typeFields(t reflect.Type) []structField {
+	var fields []structField
+
+	var walk func(t reflect.Type, index []int, visited map[reflect.Type]bool)
+	walk = func(t reflect.Type, index []int, visited map[reflect.Type]bool) {
+		if visited[t] {
+			return
+		}
+		visited[t] = true
+		defer delete(visited, t)
+
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag, ok := sf.Tag.Lookup("convert")
+			if !ok {
+				tag = sf.Tag.Get("json")
+			}
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			idx := append(append([]int(nil), index...), i)
+
+			if (sf.Anonymous && name == "") || hasTagOption(opts, "inline") {
+				ft := sf.Type
+				if ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					// Promoted fields are settable through unexported embedded structs,
+					// but not through unexported pointers or named fields.
+					if sf.IsExported() || (sf.Anonymous && sf.Type.Kind() == reflect.Struct) {
+						walk(ft, idx, visited)
+					}
+					continue
+				}
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			fields = append(fields, structField{name: name, goName: sf.Name, index: idx, opts: opts})
+		}
+	}
+	walk(t, nil, map[reflect.Type]bool{})
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return len(fields[i].index) < len(fields[j].index)
+	})
+	seen := make(map[string]bool, len(fields))
+	res := fields[:0]
+	for _, f := range fields {
+		if seen[f.name] {
+			continue
+		}
+		seen[f.name] = true
+		res = append(res, f)
+	}
+	return res
+}
+
+// hasTagOption reports whether the comma-separated tag options contain the given option.
+func hasTagOption(opts, option string) bool {
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByIndex returns the field of v at the given index sequence,
+// allocating the nil embedded pointers on the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
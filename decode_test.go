@@ -0,0 +1,210 @@
+package convert
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type decodeItem struct {
+	Name  string  `convert:"name"`
+	Price float64 `convert:"price"`
+}
+
+type decodeBase struct {
+	ID      int       `convert:"id"`
+	Created time.Time `convert:"created"`
+}
+
+type DecodeAudit struct {
+	By string `convert:"by"`
+}
+
+type decodeOrder struct {
+	decodeBase
+	*DecodeAudit
+	Customer struct {
+		Name  string
+		Email *string `convert:"email"`
+	} `convert:"customer"`
+	Items    []decodeItem          `convert:"items"`
+	Tags     map[string]string     `convert:"tags"`
+	Counts   map[int]uint8         `convert:"counts"`
+	Refs     []*decodeItem         `convert:"refs"`
+	Matrix   [2][]int              `convert:"matrix"`
+	Extra    interface{}           `convert:"extra"`
+	Lookup   map[string]decodeItem `convert:"lookup"`
+	Ignored  string                `convert:"-"`
+	internal string
+}
+
+func TestDecode(t *testing.T) {
+	src := map[string]interface{}{
+		"id":      "42",
+		"created": "2024-03-01 10:00:00",
+		"by":      "admin",
+		"customer": map[string]interface{}{
+			"NAME":  "Ada",
+			"email": "ada@example.com",
+		},
+		"items": []interface{}{
+			map[string]interface{}{"name": "pen", "price": "1.5"},
+			map[interface{}]interface{}{"name": "ink", "price": 3},
+		},
+		"tags":    map[string]interface{}{"env": "prod"},
+		"counts":  map[string]string{"1": "10", "2": "20"},
+		"refs":    []map[string]interface{}{{"name": "ref"}},
+		"matrix":  []interface{}{[]string{"1", "2"}, []float64{3}},
+		"extra":   []int{1, 2},
+		"lookup":  map[string]interface{}{"a": map[string]interface{}{"name": "a", "price": 1}},
+		"Ignored": "x",
+		"unknown": true,
+	}
+
+	var order decodeOrder
+	err := Decode(src, &order)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 42, order.ID)
+	assert.Equal(t, 2024, order.Created.Year())
+	if assert.NotNil(t, order.DecodeAudit) {
+		assert.Equal(t, "admin", order.By)
+	}
+	assert.Equal(t, "Ada", order.Customer.Name)
+	if assert.NotNil(t, order.Customer.Email) {
+		assert.Equal(t, "ada@example.com", *order.Customer.Email)
+	}
+	assert.Equal(t, []decodeItem{{"pen", 1.5}, {"ink", 3}}, order.Items)
+	assert.Equal(t, map[string]string{"env": "prod"}, order.Tags)
+	assert.Equal(t, map[int]uint8{1: 10, 2: 20}, order.Counts)
+	if assert.Len(t, order.Refs, 1) {
+		assert.Equal(t, "ref", order.Refs[0].Name)
+	}
+	assert.Equal(t, [2][]int{{1, 2}, {3}}, order.Matrix)
+	assert.Equal(t, []int{1, 2}, order.Extra)
+	assert.Equal(t, map[string]decodeItem{"a": {"a", 1}}, order.Lookup)
+	assert.Empty(t, order.Ignored)
+}
+
+func TestDecodeErrorPath(t *testing.T) {
+	tests := []struct {
+		name string
+		src  map[string]interface{}
+		path string
+		want error
+	}{
+		{
+			name: "slice element field",
+			src: map[string]interface{}{"items": []interface{}{
+				map[string]interface{}{"price": 1},
+				map[string]interface{}{"price": "abc"},
+			}},
+			path: "Items[1].Price",
+			want: ErrSyntax,
+		},
+		{
+			name: "map value",
+			src:  map[string]interface{}{"counts": map[string]interface{}{"3": 300}},
+			path: "Counts[3]",
+			want: ErrOverflow,
+		},
+		{
+			name: "map key",
+			src:  map[string]interface{}{"counts": map[string]interface{}{"x": 1}},
+			path: "Counts[x]",
+			want: ErrSyntax,
+		},
+		{
+			name: "promoted field",
+			src:  map[string]interface{}{"id": "1.5.2"},
+			path: "ID",
+			want: ErrSyntax,
+		},
+		{
+			name: "nested struct",
+			src:  map[string]interface{}{"customer": "Ada"},
+			path: "Customer",
+			want: ErrUnsupported,
+		},
+		{
+			name: "array too long",
+			src:  map[string]interface{}{"matrix": []interface{}{nil, nil, nil}},
+			path: "Matrix",
+			want: ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var order decodeOrder
+			err := Decode(tt.src, &order)
+			assert.ErrorIs(t, err, tt.want)
+			var convErr *ConversionError
+			if assert.True(t, errors.As(err, &convErr)) {
+				assert.Equal(t, tt.path, convErr.Path)
+			}
+		})
+	}
+}
+
+func TestDecodeDestination(t *testing.T) {
+	var order decodeOrder
+	err := Decode(map[string]interface{}{}, order)
+	assert.ErrorIs(t, err, ErrUnsupported)
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, reflect.TypeOf(order), convErr.To)
+	}
+	assert.ErrorIs(t, Decode(map[string]interface{}{}, (*decodeOrder)(nil)), ErrNil)
+	assert.ErrorIs(t, Decode(map[string]interface{}{}, nil), ErrNil)
+
+	var n int
+	assert.NoError(t, Decode("12", &n))
+	assert.Equal(t, 12, n)
+
+	var p *int
+	assert.NoError(t, Decode(7, &p))
+	if assert.NotNil(t, p) {
+		assert.Equal(t, 7, *p)
+	}
+	assert.NoError(t, Decode(nil, &p))
+	assert.Nil(t, p)
+
+	var b []byte
+	assert.NoError(t, Decode("raw", &b))
+	assert.Equal(t, []byte("raw"), b)
+}
+
+func TestDecodeWithConverter(t *testing.T) {
+	var item decodeItem
+	err := Strict().Decode(map[string]interface{}{"price": true}, &item)
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	var base decodeBase
+	err = New(WithRounding(RoundHalfUp)).Decode(map[string]interface{}{"id": 1.5}, &base)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, base.ID)
+}
+
+func TestDecodeCaseInsensitiveOrder(t *testing.T) {
+	src := map[string]interface{}{"name": "lower", "NAME": "upper", "Name2": "x"}
+	for i := 0; i < 50; i++ {
+		var v struct{ NaMe string }
+		assert.NoError(t, Decode(src, &v))
+		assert.Equal(t, "upper", v.NaMe)
+	}
+}
+
+type decodeCycle struct {
+	*decodeCycle
+	Name string
+}
+
+func TestDecodeRecursiveEmbedding(t *testing.T) {
+	var v decodeCycle
+	assert.NoError(t, Decode(map[string]interface{}{"Name": "x"}, &v))
+	assert.Equal(t, "x", v.Name)
+}
@@ -0,0 +1,127 @@
+package convert
+
+import (
+	"reflect"
+)
+
+// isPlainStruct reports whether t is a struct type that the map converters turn into a map.
+// Structs with a dedicated conversion, like time.Time, and structs implementing
+// encoding.TextMarshaler or driver.Valuer are kept as values.
+func isPlainStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if _, ok := casters[t]; ok {
+		return false
+	}
+	for _, i := range []reflect.Type{textMarshalerType, valuerType} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// structToMap returns the fields of the struct v as a map keyed by field name.
+// Field names, omitted fields and promoted fields follow the same rules as Decode:
+// `convert:"name"` or `json:"name"` tags, "-", "omitempty", "inline" and embedded structs.
+// Nested structs are converted to maps too when the struct recursion option is enabled.
+func (c *Converter) structToMap(v reflect.Value) map[string]interface{} {
+	fields := cachedFields(v.Type())
+	res := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		field, ok := fieldByIndexNoAlloc(v, f.index)
+		if !ok {
+			continue
+		}
+		if hasTagOption(f.opts, "omitempty") && isEmptyValue(field) {
+			continue
+		}
+		res[f.name] = c.encodeValue(field)
+	}
+	return res
+}
+
+// encodeValue returns the value of a struct field for structToMap.
+// With the struct recursion option, nested structs, including those behind pointers
+// and inside slices, arrays and maps, are converted to maps.
+func (c *Converter) encodeValue(v reflect.Value) interface{} {
+	if !c.opts.structRecursion {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			return c.encodeValue(v.Elem())
+		}
+	case reflect.Pointer:
+		if !v.IsNil() && isPlainStruct(v.Type().Elem()) {
+			return c.structToMap(v.Elem())
+		}
+	case reflect.Struct:
+		if isPlainStruct(v.Type()) {
+			return c.structToMap(v)
+		}
+	case reflect.Slice, reflect.Array:
+		if (v.Kind() == reflect.Slice && v.IsNil()) || !containsStruct(v.Type().Elem()) {
+			return v.Interface()
+		}
+		res := make([]interface{}, v.Len())
+		for i := range res {
+			res[i] = c.encodeValue(v.Index(i))
+		}
+		return res
+	case reflect.Map:
+		if v.IsNil() || !containsStruct(v.Type().Elem()) {
+			return v.Interface()
+		}
+		res := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, _ := c.plainString(iter.Key().Interface())
+			res[key] = c.encodeValue(iter.Value())
+		}
+		return res
+	}
+	return v.Interface()
+}
+
+// containsStruct reports whether values of type t may hold structs to convert.
+func containsStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Interface || isPlainStruct(t)
+}
+
+// fieldByIndexNoAlloc returns the field of v at the given index sequence.
+// The boolean result is false when a nil embedded pointer hides the field.
+func fieldByIndexNoAlloc(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// isEmptyValue reports whether v is empty in the sense of the "omitempty" tag option:
+// false, 0, a nil pointer or interface, or an empty array, slice, map or string.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type encodeAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type encodeMeta struct {
+	Version int `convert:"version"`
+}
+
+type EncodeAudit struct {
+	By string `convert:"by"`
+}
+
+type encodeUser struct {
+	encodeMeta
+	*EncodeAudit
+	Name     string            `convert:"name" json:"full_name"`
+	Email    string            `json:"email,omitempty"`
+	Age      int               `convert:"age,omitempty"`
+	Password string            `convert:"-"`
+	Address  encodeAddress     `convert:"address"`
+	Billing  encodeAddress     `convert:",inline"`
+	Previous *encodeAddress    `convert:"previous"`
+	Friends  []encodeAddress   `convert:"friends"`
+	Labels   map[string]string `convert:"labels"`
+	Joined   time.Time         `convert:"joined"`
+	Note     string
+	secret   string
+}
+
+func TestToMapStringInterfaceStruct(t *testing.T) {
+	joined := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	user := encodeUser{
+		encodeMeta: encodeMeta{Version: 3},
+		Name:       "Ada",
+		Password:   "hunter2",
+		Address:    encodeAddress{City: "London", Zip: "N1"},
+		Billing:    encodeAddress{City: "Paris"},
+		Previous:   &encodeAddress{City: "Oxford"},
+		Friends:    []encodeAddress{{City: "Bath"}},
+		Labels:     map[string]string{"team": "core"},
+		Joined:     joined,
+		Note:       "n",
+		secret:     "s",
+	}
+
+	got, err := ToMapStringInterfaceE(user)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"version":  3,
+		"name":     "Ada",
+		"address":  encodeAddress{City: "London", Zip: "N1"},
+		"city":     "Paris",
+		"previous": &encodeAddress{City: "Oxford"},
+		"friends":  []encodeAddress{{City: "Bath"}},
+		"labels":   map[string]string{"team": "core"},
+		"joined":   joined,
+		"Note":     "n",
+	}, got)
+
+	fromPtr, err := ToMapStringInterfaceE(&user)
+	assert.NoError(t, err)
+	assert.Equal(t, got, fromPtr)
+
+	user.EncodeAudit = &EncodeAudit{By: "root"}
+	got, err = ToMapStringInterfaceE(user)
+	assert.NoError(t, err)
+	assert.Equal(t, "root", got["by"])
+}
+
+func TestToMapStringInterfaceStructRecursion(t *testing.T) {
+	user := encodeUser{
+		Name:     "Ada",
+		Address:  encodeAddress{City: "London"},
+		Previous: &encodeAddress{City: "Oxford", Zip: "OX1"},
+		Friends:  []encodeAddress{{City: "Bath"}},
+	}
+
+	got, err := New(WithStructRecursion(true)).ToMapStringInterfaceE(user)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"city": "London"}, got["address"])
+	assert.Equal(t, map[string]interface{}{"city": "Oxford", "zip": "OX1"}, got["previous"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"city": "Bath"}}, got["friends"])
+	assert.Equal(t, time.Time{}, got["joined"])
+}
+
+func TestToMapStringStringStruct(t *testing.T) {
+	got, err := ToMapStringStringE(encodeUser{Name: "Ada", Age: 36, Billing: encodeAddress{City: "Paris"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Ada", got["name"])
+	assert.Equal(t, "36", got["age"])
+	assert.Equal(t, "Paris", got["city"])
+	assert.NotContains(t, got, "email")
+
+	ints, err := ToMapStringIntE(struct {
+		A int
+		B string `json:"b"`
+	}{A: 1, B: "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"A": 1, "b": 2}, ints)
+
+	_, err = ToMapStringIntE(struct{ A string }{A: "x"})
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	_, err = ToMapStringInterfaceE(time.Now())
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestDecodeStructRoundTrip(t *testing.T) {
+	user := encodeUser{Name: "Ada", Age: 36, Address: encodeAddress{City: "London"}, Billing: encodeAddress{City: "Paris"}}
+
+	m, err := New(WithStructRecursion(true)).ToMapStringInterfaceE(user)
+	assert.NoError(t, err)
+
+	var decoded encodeUser
+	assert.NoError(t, Decode(m, &decoded))
+	assert.Equal(t, user, decoded)
+}
@@ -0,0 +1,203 @@
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Sentinel causes of a ConversionError, usable with errors.Is.
+var (
+	// ErrOverflow reports a value outside the range of the target type.
+	ErrOverflow = errors.New("value out of range")
+	// ErrSyntax reports a string that cannot be parsed as the target type.
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrUnsupported reports a source type that cannot be converted to the target type.
+	ErrUnsupported = errors.New("unsupported type")
+	// ErrPrecisionLoss reports a conversion that would lose information.
+	ErrPrecisionLoss = errors.New("precision loss")
+	// ErrNil reports a nil value where a value is required.
+	ErrNil = errors.New("nil value")
+	// ErrDivisionByZero reports a Decimal division by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// ConversionError describes a failed conversion.
+// Its cause is one of the sentinel errors (ErrOverflow, ErrSyntax, ...) or an error
+// wrapping one of them, so that callers can use errors.Is and errors.As:
+//
+//	_, err := convert.ToInt8E(300)
+//	if errors.Is(err, convert.ErrOverflow) {
+//		// handle overflow
+//	}
+//
+//	var convErr *convert.ConversionError
+//	if errors.As(err, &convErr) {
+//		fmt.Println(convErr.Path, convErr.To)
+//	}
+type ConversionError struct {
+	// Value is the value that could not be converted.
+	Value interface{}
+	// From is the type of Value, or nil if Value is nil.
+	From reflect.Type
+	// To is the target type of the conversion.
+	To reflect.Type
+	// Path locates Value inside the converted container, like "[2]" or "Items[2].Name".
+	// It is empty when the top-level value failed.
+	Path string
+	// Err is the cause of the failure.
+	Err error
+
+	// sign is the direction of an ErrOverflow: -1 below the range of To, 1 above it,
+	// and 0 when it is unknown.
+	sign int
+	// nan reports an ErrOverflow of NaN, which has no direction.
+	nan bool
+}
+
+// Error implements the error interface.
+func (e *ConversionError) Error() string {
+	var b strings.Builder
+	b.WriteString("convert: cannot convert ")
+	b.WriteString(formatErrorValue(e.Value))
+	if e.From != nil {
+		fmt.Fprintf(&b, " (%v)", e.From)
+	}
+	if e.To != nil {
+		fmt.Fprintf(&b, " to %v", e.To)
+	}
+	if e.Path != "" {
+		fmt.Fprintf(&b, " at %s", e.Path)
+	}
+	if e.Err != nil {
+		b.WriteString(": ")
+		b.WriteString(e.Err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the cause of the failure.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// formatErrorValue formats a value for an error message.
+func formatErrorValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// newError returns a ConversionError for the given value, target type and cause.
+func newError(value interface{}, to reflect.Type, cause error) error {
+	return &ConversionError{Value: value, From: reflect.TypeOf(value), To: to, Err: cause}
+}
+
+// stringFormError reports err, the error of the conversion of the string form of value,
+// as an error of value itself, so that it keeps the original value and type.
+func stringFormError(err error, value interface{}, to reflect.Type) error {
+	if err == nil {
+		return nil
+	}
+	if ce, ok := err.(*ConversionError); ok {
+		res := *ce
+		res.Value, res.From, res.To = value, reflect.TypeOf(value), to
+		return &res
+	}
+	return newError(value, to, err)
+}
+
+// overflowError returns the ErrOverflow ConversionError of a value below the range of to
+// when sign is negative, and above it when sign is positive.
+func overflowError(value interface{}, to reflect.Type, sign int) error {
+	return &ConversionError{Value: value, From: reflect.TypeOf(value), To: to, Err: ErrOverflow, sign: sign}
+}
+
+// nanError returns the ErrOverflow ConversionError of a NaN value converted to to.
+func nanError(value interface{}, to reflect.Type) error {
+	return &ConversionError{Value: value, From: reflect.TypeOf(value), To: to, Err: ErrOverflow, nan: true}
+}
+
+// isNaNOverflow reports whether err is the ErrOverflow of a NaN value.
+func isNaNOverflow(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if ce, ok := err.(*ConversionError); ok && ce.nan {
+			return true
+		}
+	}
+	return false
+}
+
+// overflowSign returns the direction of the overflow reported by err, or 0 if it is unknown.
+func overflowSign(err error) int {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if ce, ok := err.(*ConversionError); ok && ce.sign != 0 {
+			return ce.sign
+		}
+	}
+	return 0
+}
+
+// signOf returns -1, 0 or 1 for negative, zero and positive n.
+func signOf(n int64) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// unsupportedError returns the error for a value whose type cannot be converted to the given type.
+func unsupportedError(value interface{}, to reflect.Type) error {
+	if value == nil || isNullValuer(value) {
+		return newError(value, to, ErrNil)
+	}
+	return newError(value, to, ErrUnsupported)
+}
+
+// syntaxError wraps a parsing error so that it matches ErrSyntax.
+func syntaxError(err error) error {
+	return fmt.Errorf("%w: %w", ErrSyntax, err)
+}
+
+// pathError locates the error of a nested value inside its container.
+// ConversionErrors get the segment prepended to their path; other errors are wrapped
+// into a ConversionError for the given value and target type.
+func pathError(err error, segment string, value interface{}, to reflect.Type) error {
+	if ce, ok := err.(*ConversionError); ok {
+		res := *ce
+		res.Path = joinPath(segment, ce.Path)
+		return &res
+	}
+	return &ConversionError{Value: value, From: reflect.TypeOf(value), To: to, Path: segment, Err: err}
+}
+
+// indexPath returns the path segment of a slice or array element.
+func indexPath(i int) string {
+	return fmt.Sprintf("[%d]", i)
+}
+
+// keyPath returns the path segment of a map value.
+func keyPath(key string) string {
+	return "[" + key + "]"
+}
+
+// joinPath joins a path segment and the rest of a path.
+func joinPath(segment, rest string) string {
+	if rest == "" {
+		return segment
+	}
+	if segment == "" || strings.HasPrefix(rest, "[") {
+		return segment + rest
+	}
+	return segment + "." + rest
+}
@@ -0,0 +1,164 @@
+package convert
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversionErrorSentinels(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() error
+		want    error
+	}{
+		{"int8 overflow", func() error { _, err := ToInt8E(300); return err }, ErrOverflow},
+		{"uint to int overflow", func() error { _, err := ToInt64E(uint64(1) << 63); return err }, ErrOverflow},
+		{"negative to uint", func() error { _, err := ToUintE(-1); return err }, ErrOverflow},
+		{"int16 string overflow", func() error { _, err := ToInt16E("70000"); return err }, ErrOverflow},
+		{"int syntax", func() error { _, err := ToIntE("abc"); return err }, ErrSyntax},
+		{"float syntax", func() error { _, err := ToFloat64E("1.2.3"); return err }, ErrSyntax},
+		{"bool syntax", func() error { _, err := ToBoolE("maybe"); return err }, ErrSyntax},
+		{"bool unsupported", func() error { _, err := ToBoolE(struct{}{}); return err }, ErrUnsupported},
+		{"duration syntax", func() error { _, err := ToDurationE("soon"); return err }, ErrSyntax},
+		{"time syntax", func() error { _, err := ToTimeE("not-a-date"); return err }, ErrSyntax},
+		{"time empty", func() error { _, err := ToTimeE(""); return err }, ErrEmptyString},
+		{"json syntax", func() error { _, err := ToSliceIntE("[1,"); return err }, ErrSyntax},
+		{"array unsupported", func() error { _, err := ToIntArrayE(42); return err }, ErrUnsupported},
+		{"array nil", func() error { _, err := ToIntArrayE(nil); return err }, ErrNil},
+		{"json nil", func() error { _, err := ToJsonE(nil); return err }, ErrNil},
+		{"value unsupported", func() error { _, err := ToValueE(1, reflect.TypeOf(struct{}{})); return err }, ErrUnsupported},
+		{"empty string", func() error {
+			_, err := New(WithEmptyStringAsZero(false)).ToIntE("")
+			return err
+		}, ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convert()
+			assert.ErrorIs(t, err, tt.want)
+			var convErr *ConversionError
+			assert.True(t, errors.As(err, &convErr))
+		})
+	}
+}
+
+func TestConversionErrorFields(t *testing.T) {
+	_, err := ToInt8E(int64(300))
+
+	var convErr *ConversionError
+	if assert.True(t, errors.As(err, &convErr)) {
+		assert.Equal(t, int64(300), convErr.Value)
+		assert.Equal(t, reflect.TypeOf(int64(0)), convErr.From)
+		assert.Equal(t, reflect.TypeOf(int8(0)), convErr.To)
+		assert.Empty(t, convErr.Path)
+		assert.Equal(t, ErrOverflow, convErr.Err)
+	}
+	assert.EqualError(t, err, "convert: cannot convert 300 (int64) to int8: value out of range")
+}
+
+func TestConversionErrorStringForm(t *testing.T) {
+	type point struct{ X int }
+	tests := []struct {
+		name    string
+		convert func() error
+		to      reflect.Type
+	}{
+		{"int", func() error { _, err := ToIntE(point{}); return err }, reflect.TypeOf(0)},
+		{"uint8", func() error { _, err := ToUint8E(point{}); return err }, reflect.TypeOf(uint8(0))},
+		{"float64", func() error { _, err := ToFloat64E(point{}); return err }, reflect.TypeOf(0.0)},
+		{"big.Int", func() error { _, err := ToBigIntE(point{}); return err }, bigIntType},
+		{"Decimal", func() error { _, err := ToDecimalE(point{}); return err }, decimalType},
+		{"time.Time", func() error { _, err := ToTimeE(point{}); return err }, reflect.TypeOf(time.Time{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convert()
+			assert.ErrorIs(t, err, ErrSyntax)
+			var convErr *ConversionError
+			if assert.True(t, errors.As(err, &convErr)) {
+				assert.Equal(t, reflect.TypeOf(point{}), convErr.From)
+				assert.Equal(t, tt.to, convErr.To)
+			}
+		})
+	}
+
+	_, err := ToIntE(point{X: 1})
+	assert.EqualError(t, err, "convert: cannot convert {1} (convert.point) to int: invalid syntax")
+}
+
+func TestConversionErrorOverflowSource(t *testing.T) {
+	type port uint16
+	tests := []struct {
+		name    string
+		convert func() error
+		value   interface{}
+		to      reflect.Type
+	}{
+		{"string to uint8", func() error { _, err := ToUint8E("300"); return err }, "300", reflect.TypeOf(uint8(0))},
+		{"int to uint8", func() error { _, err := ToUint8E(300); return err }, 300, reflect.TypeOf(uint8(0))},
+		{"int16 to uint8", func() error { _, err := ToUint8E(int16(-5)); return err }, int16(-5), reflect.TypeOf(uint8(0))},
+		{"uint to int8", func() error { _, err := ToInt8E(uint(200)); return err }, uint(200), reflect.TypeOf(int8(0))},
+		{"uint64 to int64", func() error { _, err := ToInt64E(uint64(1) << 63); return err }, uint64(1) << 63, reflect.TypeOf(int64(0))},
+		{"string to int16", func() error { _, err := ToInt16E("70000"); return err }, "70000", reflect.TypeOf(int16(0))},
+		{"float string to int32", func() error { _, err := ToInt32E("1e30"); return err }, "1e30", reflect.TypeOf(int32(0))},
+		{"bytes to uint32", func() error { _, err := ToUint32E([]byte("5000000000")); return err }, []byte("5000000000"), reflect.TypeOf(uint32(0))},
+		{"defined type", func() error { _, err := ToValueE("70000", reflect.TypeOf(port(0))); return err }, "70000", reflect.TypeOf(uint16(0))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convert()
+			assert.ErrorIs(t, err, ErrOverflow)
+			var convErr *ConversionError
+			if assert.True(t, errors.As(err, &convErr)) {
+				assert.Equal(t, tt.value, convErr.Value)
+				assert.Equal(t, reflect.TypeOf(tt.value), convErr.From)
+				assert.Equal(t, tt.to, convErr.To)
+			}
+		})
+	}
+
+	_, err := ToInt8ArrayE([]interface{}{1, 300})
+	assert.EqualError(t, err, "convert: cannot convert 300 (int) to int8 at [1]: value out of range")
+}
+
+func TestConversionErrorPath(t *testing.T) {
+	_, err := ToIntArrayE([]string{"1", "2", "abc"})
+	var convErr *ConversionError
+	if assert.True(t, errors.As(err, &convErr)) {
+		assert.Equal(t, "[2]", convErr.Path)
+		assert.Equal(t, "abc", convErr.Value)
+	}
+	assert.ErrorIs(t, err, ErrSyntax)
+	assert.EqualError(t, err, `convert: cannot convert "abc" (string) to int at [2]: invalid syntax`)
+
+	_, err = ToSliceIntE([]interface{}{1, "x"})
+	if assert.True(t, errors.As(err, &convErr)) {
+		assert.Equal(t, "[1]", convErr.Path)
+	}
+
+	_, err = ToMapStringIntE(map[string]interface{}{"a": "x"})
+	if assert.True(t, errors.As(err, &convErr)) {
+		assert.Equal(t, "[a]", convErr.Path)
+		assert.Equal(t, "x", convErr.Value)
+	}
+
+	_, err = ToMapStringDurationE(map[int]string{7: "soon"})
+	if assert.True(t, errors.As(err, &convErr)) {
+		assert.Equal(t, "[7]", convErr.Path)
+		assert.Equal(t, reflect.TypeOf(time.Duration(0)), convErr.To)
+	}
+}
+
+func TestJoinPath(t *testing.T) {
+	assert.Equal(t, "[1]", joinPath("[1]", ""))
+	assert.Equal(t, "[1][2]", joinPath("[1]", "[2]"))
+	assert.Equal(t, "Items.Name", joinPath("Items", "Name"))
+	assert.Equal(t, "Items[0]", joinPath("Items", "[0]"))
+}
@@ -0,0 +1,189 @@
+package convert
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// numberStyle holds the options that control how ToStringE formats numbers.
+type numberStyle struct {
+	// verb and precision are the format and precision of strconv.FormatFloat for floats.
+	verb      byte
+	precision int
+
+	// width is the minimum number of characters, padded with zeros after the sign
+	// when zeroPad is set, or with spaces on the left otherwise.
+	width   int
+	zeroPad bool
+
+	// grouping separates the thousands of the integer part when not zero,
+	// and decimal replaces the decimal point when not zero.
+	grouping rune
+	decimal  rune
+}
+
+// defaultNumberStyle formats numbers as Go literals, with the shortest float representation.
+var defaultNumberStyle = numberStyle{verb: 'f', precision: -1}
+
+// WithFloatFormat sets how ToStringE formats float types and Decimal values, with the verb
+// and precision of strconv.FormatFloat: 'f' for -ddd.dddd, 'e' for -d.dddde±dd, 'g' for
+// 'e' with large exponents and 'f' otherwise. The precision is the number of digits after
+// the point for 'f' and 'e', and the number of significant digits for 'g'; -1 uses the
+// fewest digits that represent the value exactly. The default is 'f' with -1.
+//
+// Example:
+//
+//	report := convert.New(convert.WithFloatFormat('f', 2))
+//	fmt.Println(report.ToString(3.14159)) // Output: 3.14
+func WithFloatFormat(verb byte, precision int) Option {
+	return func(o *options) {
+		o.numberStyle.verb = verb
+		o.numberStyle.precision = precision
+	}
+}
+
+// WithNumberWidth sets the minimum width, in characters, of the numbers formatted by ToStringE.
+// Shorter numbers are padded with zeros after the sign when zeroPad is set, like "-0042",
+// and with spaces on the left otherwise. NaN and infinities are always padded with spaces.
+func WithNumberWidth(width int, zeroPad bool) Option {
+	return func(o *options) {
+		o.numberStyle.width = width
+		o.numberStyle.zeroPad = zeroPad
+	}
+}
+
+// WithNumberGrouping sets the separator ToStringE puts between the thousands of numbers,
+// like ',' in "1,234,567". The zero rune, which is the default, disables grouping.
+func WithNumberGrouping(sep rune) Option {
+	return func(o *options) {
+		o.numberStyle.grouping = sep
+	}
+}
+
+// WithFormatLocale makes ToStringE format numbers with the decimal separator of f and
+// the first of its grouping separators, so that WithNumberFormat(f) parses them back.
+//
+// Example:
+//
+//	de, _ := convert.NumberFormatFor("de-DE")
+//	export := convert.New(convert.WithFormatLocale(de), convert.WithFloatFormat('f', 2))
+//	fmt.Println(export.ToString(1234.5)) // Output: 1.234,50
+func WithFormatLocale(f NumberFormat) Option {
+	return func(o *options) {
+		o.numberStyle.decimal = f.Decimal
+		o.numberStyle.grouping, _ = utf8.DecodeRuneInString(f.Grouping)
+		if f.Grouping == "" {
+			o.numberStyle.grouping = 0
+		}
+	}
+}
+
+// formatNumber formats integer, float and Decimal values with the number style of c.
+// It reports false for other values, and for all values when the style is the default one.
+// Only ToStringE uses it: the strings that the converters parse back come from plainString.
+func (c *Converter) formatNumber(value interface{}) (string, bool) {
+	style := c.opts.numberStyle
+	if style == defaultNumberStyle {
+		return "", false
+	}
+
+	v := Indirect(value)
+	if _, ok := v.(Decimal); !ok {
+		if _, ok := textMarshaler(v); ok {
+			// Like ByteSize, the type has its own text form.
+			return "", false
+		}
+	}
+
+	var s string
+	switch n := basicValue(v).(type) {
+	case int, int8, int16, int32, int64:
+		s = strconv.FormatInt(reflect.ValueOf(n).Int(), 10)
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		s = strconv.FormatUint(reflect.ValueOf(n).Uint(), 10)
+	case float32:
+		s = strconv.FormatFloat(float64(n), style.verb, style.precision, 32)
+	case float64:
+		s = strconv.FormatFloat(n, style.verb, style.precision, 64)
+	case Decimal:
+		s = style.formatDecimal(n)
+	default:
+		return "", false
+	}
+	return style.apply(s), true
+}
+
+// formatDecimal formats d with the verb and precision of the style.
+// The 'f' verb keeps all the digits of d, rounding half to even to the precision.
+func (style numberStyle) formatDecimal(d Decimal) string {
+	switch {
+	case style.verb == 'f' && style.precision < 0:
+		return d.String()
+	case style.verb == 'f':
+		res, _ := d.Round(int32(style.precision), RoundHalfEven)
+		return res.String()
+	case style.precision < 0:
+		f, _ := d.Float64()
+		return strconv.FormatFloat(f, style.verb, -1, 64)
+	default:
+		return new(big.Float).SetPrec(bigFloatPrec).SetRat(d.Rat()).Text(style.verb, style.precision)
+	}
+}
+
+// apply groups the digits of the number s, replaces its decimal point and pads it
+// to the width of the style.
+func (style numberStyle) apply(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	end := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+	if end < 0 {
+		end = len(s)
+	}
+	if end == 0 {
+		// NaN and infinities.
+		return padLeft(sign+s, style.width, ' ')
+	}
+	intPart, rest := s[:end], s[end:]
+
+	if style.decimal != 0 && strings.HasPrefix(rest, ".") {
+		rest = string(style.decimal) + rest[1:]
+	}
+
+	if style.zeroPad {
+		for len(sign)+groupedLen(len(intPart), style.grouping)+utf8.RuneCountInString(rest) < style.width {
+			intPart = "0" + intPart
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	for i := 0; i < len(intPart); i++ {
+		if style.grouping != 0 && i > 0 && (len(intPart)-i)%3 == 0 {
+			sb.WriteRune(style.grouping)
+		}
+		sb.WriteByte(intPart[i])
+	}
+	sb.WriteString(rest)
+	return padLeft(sb.String(), style.width, ' ')
+}
+
+// groupedLen returns the number of characters of n digits grouped by thousands with sep.
+func groupedLen(n int, sep rune) int {
+	if sep == 0 || n == 0 {
+		return n
+	}
+	return n + (n-1)/3
+}
+
+// padLeft pads s on the left with pad up to width characters.
+func padLeft(s string, width int, pad rune) string {
+	if n := width - utf8.RuneCountInString(s); n > 0 {
+		return strings.Repeat(string(pad), n) + s
+	}
+	return s
+}
@@ -0,0 +1,111 @@
+package convert
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberStyle(t *testing.T) {
+	de, _ := NumberFormatFor("de-DE")
+	fr, _ := NumberFormatFor("fr-FR")
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		input    interface{}
+		expected string
+	}{
+		{"Default", nil, 1e21, "1000000000000000000000"},
+		{"Fixed", []Option{WithFloatFormat('f', 2)}, 3.14159, "3.14"},
+		{"FixedRounding", []Option{WithFloatFormat('f', 0)}, 2.5, "2"},
+		{"Float32", []Option{WithFloatFormat('f', 3)}, float32(0.1), "0.100"},
+		{"Exponent", []Option{WithFloatFormat('e', 3)}, 1e300, "1.000e+300"},
+		{"General", []Option{WithFloatFormat('g', -1)}, 1e300, "1e+300"},
+		{"GeneralSmall", []Option{WithFloatFormat('g', 4)}, 123.456, "123.5"},
+		{"IntegerUnchanged", []Option{WithFloatFormat('e', 2)}, 42, "42"},
+		{"Grouping", []Option{WithNumberGrouping(',')}, 1234567, "1,234,567"},
+		{"GroupingNegative", []Option{WithNumberGrouping(',')}, -1234.5, "-1,234.5"},
+		{"GroupingSmall", []Option{WithNumberGrouping(',')}, 123, "123"},
+		{"GroupingUint", []Option{WithNumberGrouping('_')}, uint64(math.MaxUint64), "18_446_744_073_709_551_615"},
+		{"Width", []Option{WithNumberWidth(6, false)}, 42, "    42"},
+		{"ZeroPad", []Option{WithNumberWidth(6, true)}, -42, "-00042"},
+		{"ZeroPadFloat", []Option{WithNumberWidth(8, true), WithFloatFormat('f', 2)}, 3.5, "00003.50"},
+		{"ZeroPadGrouping", []Option{WithNumberWidth(7, true), WithNumberGrouping(',')}, 12, "000,012"},
+		{"WidthExceeded", []Option{WithNumberWidth(2, true)}, 12345, "12345"},
+		{"NaN", []Option{WithNumberWidth(5, true)}, math.NaN(), "  NaN"},
+		{"Inf", []Option{WithNumberGrouping(',')}, math.Inf(-1), "-Inf"},
+		{"German", []Option{WithFormatLocale(de), WithFloatFormat('f', 2)}, 1234.5, "1.234,50"},
+		{"French", []Option{WithFormatLocale(fr)}, -1234567.25, "-1 234 567,25"},
+		{"ExponentLocale", []Option{WithFormatLocale(de), WithFloatFormat('e', 2)}, 12345.0, "1,23e+04"},
+		{"Named", []Option{WithNumberGrouping(',')}, namedCelsius(1000.5), "1,000.5"},
+		{"Pointer", []Option{WithNumberGrouping(',')}, ToPtr(5000), "5,000"},
+		{"Decimal", []Option{WithNumberGrouping(',')}, NewDecimal(123456789, 2), "1,234,567.89"},
+		{"DecimalFixed", []Option{WithFloatFormat('f', 1)}, NewDecimal(225, 2), "2.2"},
+		{"DecimalPad", []Option{WithFloatFormat('f', 4)}, NewDecimal(15, 1), "1.5000"},
+		{"DecimalExponent", []Option{WithFloatFormat('e', 2)}, NewDecimal(123456, 0), "1.23e+05"},
+		{"String", []Option{WithNumberGrouping(',')}, "1234", "1234"},
+		{"Bool", []Option{WithNumberWidth(6, true)}, true, "true"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := New(test.opts...).ToStringE(test.input)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestNumberStyleFamilies(t *testing.T) {
+	de, _ := NumberFormatFor("de-DE")
+	export := New(WithFormatLocale(de), WithFloatFormat('f', 2))
+
+	s, err := export.ToSliceStringE([]interface{}{1234.5, 2, "x"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.234,50", "2", "x"}, s)
+
+	m, err := export.ToMapStringStringE(map[string]interface{}{"total": 99.999})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"total": "100,00"}, m)
+
+	parsed, err := New(WithNumberFormat(de)).ToFloat64E(export.ToString(-9876543.21))
+	assert.NoError(t, err)
+	assert.Equal(t, -9876543.21, parsed)
+
+	assert.Equal(t, "1234.5", ToString(1234.5))
+}
+
+// rawText keeps the text it is unmarshalled from.
+type rawText struct {
+	s string
+}
+
+func (r *rawText) UnmarshalText(b []byte) error {
+	r.s = string(b)
+	return nil
+}
+
+func TestNumberStyleInternalStrings(t *testing.T) {
+	de, _ := NumberFormatFor("de-DE")
+	styled := New(WithNumberGrouping(','), WithFormatLocale(de), WithFloatFormat('f', 1))
+
+	tm, err := New(WithNumberGrouping(',')).ToTimeE(20231114)
+	assert.NoError(t, err)
+	assert.Equal(t, ToTime(20231114), tm)
+
+	text, err := ToWithE[rawText](styled, 1234.56)
+	assert.NoError(t, err)
+	assert.Equal(t, "1234.56", text.s)
+
+	text, err = ToWithE[rawText](New(WithNumberGrouping(',')), 123456)
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", text.s)
+
+	m, err := styled.ToMapStringIntE(map[interface{}]interface{}{1234567: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"1234567": 1}, m)
+
+	assert.Equal(t, "1.234,6", styled.ToString(1234.56))
+}
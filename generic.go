@@ -0,0 +1,270 @@
+package convert
+
+import (
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// TypeConverter is a function type for custom conversion to any type T.
+// It takes any value and returns a pointer to a T if conversion succeeds, or nil if it fails.
+// This allows for flexible, user-defined conversion logic.
+//
+// Example usage:
+//
+//	customConverter := func(value interface{}) *UserID {
+//		if s, ok := value.(string); ok && strings.HasPrefix(s, "user-") {
+//			id := UserID(strings.TrimPrefix(s, "user-"))
+//			return &id
+//		}
+//		return nil
+//	}
+//
+//	id := To[UserID](someValue, customConverter)
+type TypeConverter[T any] func(value interface{}) *T
+
+// To converts any type of value to T, ignoring errors.
+// It uses ToE internally and returns the zero value of T if the conversion fails.
+//
+// Example:
+//
+//	n := To[int]("42")
+//	fmt.Println(n) // Output: 42
+func To[T any](value interface{}, converters ...TypeConverter[T]) T {
+	res, _ := ToE[T](value, converters...)
+	return res
+}
+
+// ToOrDefault converts any type of value to T or returns the provided default value if conversion fails.
+//
+// Example:
+//
+//	n := ToOrDefault[int]("abc", 42)
+//	fmt.Println(n) // Output: 42
+func ToOrDefault[T any](value interface{}, defaultValue T, converters ...TypeConverter[T]) T {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := ToE[T](value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToE converts any type of value to T or returns an error.
+// Built-in targets are routed to the matching typed converter:
+//   - string, bool: ToStringE, ToBoolE
+//   - integer and float types: ToIntE, ToInt8E, ..., ToFloat64E
+//   - complex64, complex128: ToComplex64E, ToComplex128E
+//   - time.Time, time.Duration: ToTimeE, ToDurationE
+//   - *big.Int, *big.Float, *big.Rat: ToBigIntE, ToBigFloatE, ToBigRatE, and their array and map variants
+//   - Decimal: ToDecimalE
+//   - ByteSize, []ByteSize, map[string]ByteSize: ToByteSizeE, ToSliceByteSizeE, ToMapStringByteSizeE
+//   - []string, []interface{}, []bool, []int, []time.Time, []time.Duration: the ToSliceXxxE family
+//   - other integer and float slices: the ToXxxArrayE family
+//   - map[string]X: the ToMapStringXxxE family
+//
+// Interface targets accept any value implementing them.
+// Every other target falls back to ToValueE.
+//
+// Example:
+//
+//	d, err := ToE[time.Duration]("1h30m")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(d) // Output: 1h30m0s
+func ToE[T any](value interface{}, converters ...TypeConverter[T]) (T, error) {
+	return ToWithE[T](std, value, converters...)
+}
+
+// ToWith is like To but uses the options of c.
+func ToWith[T any](c *Converter, value interface{}, converters ...TypeConverter[T]) T {
+	res, _ := ToWithE[T](c, value, converters...)
+	return res
+}
+
+// ToWithOrDefault is like ToOrDefault but uses the options of c.
+func ToWithOrDefault[T any](c *Converter, value interface{}, defaultValue T, converters ...TypeConverter[T]) T {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := ToWithE[T](c, value, converters...)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToWithE is like ToE but uses the options of c.
+// Generic functions cannot be methods, so c is passed explicitly.
+//
+// Example:
+//
+//	strict := convert.New(convert.WithEmptyStringAsZero(false))
+//	n, err := convert.ToWithE[int](strict, "")
+func ToWithE[T any](c *Converter, value interface{}, converters ...TypeConverter[T]) (T, error) {
+	var zero T
+
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	var res interface{}
+	var err error
+
+	switch any(zero).(type) {
+	case string:
+		res, err = c.ToStringE(value)
+	case bool:
+		res, err = c.ToBoolE(value)
+	case int:
+		res, err = c.ToIntE(value)
+	case int8:
+		res, err = c.ToInt8E(value)
+	case int16:
+		res, err = c.ToInt16E(value)
+	case int32:
+		res, err = c.ToInt32E(value)
+	case int64:
+		res, err = c.ToInt64E(value)
+	case uint:
+		res, err = c.ToUintE(value)
+	case uint8:
+		res, err = c.ToUint8E(value)
+	case uint16:
+		res, err = c.ToUint16E(value)
+	case uint32:
+		res, err = c.ToUint32E(value)
+	case uint64:
+		res, err = c.ToUint64E(value)
+	case float32:
+		res, err = c.ToFloat32E(value)
+	case float64:
+		res, err = c.ToFloat64E(value)
+	case complex64:
+		res, err = c.ToComplex64E(value)
+	case complex128:
+		res, err = c.ToComplex128E(value)
+	case time.Time:
+		res, err = c.ToTimeE(value)
+	case time.Duration:
+		res, err = c.ToDurationE(value)
+	case *big.Int:
+		res, err = c.ToBigIntE(value)
+	case *big.Float:
+		res, err = c.ToBigFloatE(value)
+	case *big.Rat:
+		res, err = c.ToBigRatE(value)
+	case Decimal:
+		res, err = c.ToDecimalE(value)
+	case ByteSize:
+		res, err = c.ToByteSizeE(value)
+	case []ByteSize:
+		res, err = c.ToSliceByteSizeE(value)
+	case map[string]ByteSize:
+		res, err = c.ToMapStringByteSizeE(value)
+	case []string:
+		res, err = c.ToSliceStringE(value)
+	case []interface{}:
+		res, err = c.ToSliceInterfaceE(value)
+	case []bool:
+		res, err = c.ToSliceBoolE(value)
+	case []int:
+		res, err = c.ToSliceIntE(value)
+	case []time.Time:
+		res, err = c.ToSliceTimeE(value)
+	case []time.Duration:
+		res, err = c.ToSliceDurationE(value)
+	case []int8:
+		res, err = c.ToInt8ArrayE(value)
+	case []int16:
+		res, err = c.ToInt16ArrayE(value)
+	case []int32:
+		res, err = c.ToInt32ArrayE(value)
+	case []int64:
+		res, err = c.ToInt64ArrayE(value)
+	case []uint:
+		res, err = c.ToUintArrayE(value)
+	case []uint8:
+		res, err = c.ToUint8ArrayE(value)
+	case []uint16:
+		res, err = c.ToUint16ArrayE(value)
+	case []uint32:
+		res, err = c.ToUint32ArrayE(value)
+	case []uint64:
+		res, err = c.ToUint64ArrayE(value)
+	case []float32:
+		res, err = c.ToFloat32ArrayE(value)
+	case []float64:
+		res, err = c.ToFloat64ArrayE(value)
+	case []*big.Int:
+		res, err = c.ToBigIntArrayE(value)
+	case []*big.Float:
+		res, err = c.ToBigFloatArrayE(value)
+	case []*big.Rat:
+		res, err = c.ToBigRatArrayE(value)
+	case map[string]string:
+		res, err = c.ToMapStringStringE(value)
+	case map[string][]string:
+		res, err = c.ToMapStringSliceStringE(value)
+	case map[string]bool:
+		res, err = c.ToMapStringBoolE(value)
+	case map[string]int:
+		res, err = c.ToMapStringIntE(value)
+	case map[string]int64:
+		res, err = c.ToMapStringInt64E(value)
+	case map[string]float32:
+		res, err = c.ToMapStringFloat32E(value)
+	case map[string]float64:
+		res, err = c.ToMapStringFloat64E(value)
+	case map[string]time.Time:
+		res, err = c.ToMapStringTimeE(value)
+	case map[string]time.Duration:
+		res, err = c.ToMapStringDurationE(value)
+	case map[string]*big.Int:
+		res, err = c.ToMapStringBigIntE(value)
+	case map[string]*big.Float:
+		res, err = c.ToMapStringBigFloatE(value)
+	case map[string]*big.Rat:
+		res, err = c.ToMapStringBigRatE(value)
+	case map[string]interface{}:
+		res, err = c.ToMapStringInterfaceE(value)
+	default:
+		return toValueE[T](c, value)
+	}
+
+	if err != nil {
+		return zero, err
+	}
+	return res.(T), nil
+}
+
+// toValueE converts a value to T through the reflection path.
+// Interface targets are satisfied directly by any value implementing them.
+func toValueE[T any](c *Converter, value interface{}) (T, error) {
+	var zero T
+
+	to := reflect.TypeOf((*T)(nil)).Elem()
+	if to.Kind() == reflect.Interface {
+		if res, ok, err := fromRegistry[T](value); ok {
+			return res, err
+		}
+		if value == nil {
+			return zero, nil
+		}
+		if res, ok := value.(T); ok {
+			return res, nil
+		}
+		return zero, newError(value, to, ErrUnsupported)
+	}
+
+	v, err := c.ToValueE(value, to)
+	if err != nil {
+		return zero, err
+	}
+	return v.Interface().(T), nil
+}
@@ -0,0 +1,109 @@
+package convert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToE(t *testing.T) {
+	t.Run("scalars", func(t *testing.T) {
+		i, err := ToE[int]("42")
+		assert.NoError(t, err)
+		assert.Exactly(t, 42, i)
+
+		u8, err := ToE[uint8]("255")
+		assert.NoError(t, err)
+		assert.Exactly(t, uint8(255), u8)
+
+		_, err = ToE[uint8]("256")
+		assert.Error(t, err)
+
+		f, err := ToE[float64]("3.14")
+		assert.NoError(t, err)
+		assert.Exactly(t, 3.14, f)
+
+		b, err := ToE[bool]("yes")
+		assert.NoError(t, err)
+		assert.True(t, b)
+
+		s, err := ToE[string](123)
+		assert.NoError(t, err)
+		assert.Exactly(t, "123", s)
+	})
+
+	t.Run("time", func(t *testing.T) {
+		d, err := ToE[time.Duration]("1h30m")
+		assert.NoError(t, err)
+		assert.Exactly(t, 90*time.Minute, d)
+
+		tm, err := ToE[time.Time]("2022-07-02")
+		assert.NoError(t, err)
+		assert.True(t, tm.Equal(nowDate))
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		ints, err := ToE[[]int]([]interface{}{1, "2", 3.0})
+		assert.NoError(t, err)
+		assert.Exactly(t, []int{1, 2, 3}, ints)
+
+		u16s, err := ToE[[]uint16]([]string{"1", "2"})
+		assert.NoError(t, err)
+		assert.Exactly(t, []uint16{1, 2}, u16s)
+
+		strs, err := ToE[[]string](`["a","b"]`)
+		assert.NoError(t, err)
+		assert.Exactly(t, []string{"a", "b"}, strs)
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		m, err := ToE[map[string]int](map[string]interface{}{"a": "1"})
+		assert.NoError(t, err)
+		assert.Exactly(t, map[string]int{"a": 1}, m)
+	})
+
+	t.Run("interfaces", func(t *testing.T) {
+		v, err := ToE[interface{}](42)
+		assert.NoError(t, err)
+		assert.Exactly(t, 42, v)
+
+		s, err := ToE[fmt.Stringer](stringerType{"test"})
+		assert.NoError(t, err)
+		assert.Equal(t, "test", s.String())
+
+		_, err = ToE[fmt.Stringer](42)
+		assert.Error(t, err)
+	})
+
+	t.Run("custom converter", func(t *testing.T) {
+		converter := func(value interface{}) *int {
+			if value == "answer" {
+				res := 42
+				return &res
+			}
+			return nil
+		}
+
+		i, err := ToE[int]("answer", converter)
+		assert.NoError(t, err)
+		assert.Exactly(t, 42, i)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ToE[int]("abc")
+		assert.Error(t, err)
+	})
+}
+
+func TestTo(t *testing.T) {
+	assert.Exactly(t, int64(7), To[int64]("7"))
+	assert.Exactly(t, 0, To[int]("abc"))
+}
+
+func TestToOrDefault(t *testing.T) {
+	assert.Exactly(t, 7, ToOrDefault("7", 42))
+	assert.Exactly(t, 42, ToOrDefault("abc", 42))
+	assert.Exactly(t, 42, ToOrDefault(nil, 42))
+}
@@ -1,10 +1,126 @@
 package convert
 
 import (
+	"bytes"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"io"
+	"math/big"
+	"reflect"
+	"strconv"
 )
 
+// JSONNumberMode is how numbers are decoded from JSON strings into interface{} values.
+type JSONNumberMode int
+
+const (
+	// JSONNumberFloat64 decodes numbers as float64, like json.Unmarshal. It is the default.
+	JSONNumberFloat64 JSONNumberMode = iota
+	// JSONNumberUseNumber decodes numbers as json.Number, keeping their exact text.
+	JSONNumberUseNumber
+	// JSONNumberInt64 decodes integers that fit as int64 and other numbers as float64.
+	JSONNumberInt64
+)
+
+// String returns the name of the JSON number mode.
+func (m JSONNumberMode) String() string {
+	switch m {
+	case JSONNumberFloat64:
+		return "float64"
+	case JSONNumberUseNumber:
+		return "number"
+	case JSONNumberInt64:
+		return "int64"
+	default:
+		return "JSONNumberMode(" + strconv.Itoa(int(m)) + ")"
+	}
+}
+
+// unmarshalJSON decodes the JSON string or []byte data into v,
+// with the numbers decoded as set by the JSONNumberMode of c.
+func (c *Converter) unmarshalJSON(data interface{}, v interface{}) error {
+	switch c.opts.jsonNumbers {
+	case JSONNumberUseNumber:
+		return unmarshalNumbers(data, v)
+	case JSONNumberInt64:
+		if err := unmarshalNumbers(data, v); err != nil {
+			return err
+		}
+		rv := reflect.ValueOf(v).Elem()
+		rv.Set(reflect.ValueOf(int64Numbers(rv.Interface())))
+		return nil
+	default:
+		return json.Unmarshal(jsonBytes(data), v)
+	}
+}
+
+// unmarshalNumbers decodes the JSON string or []byte data into v,
+// keeping numbers as json.Number so that they are not rounded to float64.
+func unmarshalNumbers(data interface{}, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(jsonBytes(data)))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("invalid character after top-level value")
+	}
+	return nil
+}
+
+// jsonBytes returns the bytes of a JSON string or []byte.
+func jsonBytes(data interface{}) []byte {
+	switch d := data.(type) {
+	case string:
+		return []byte(d)
+	case []byte:
+		return d
+	}
+	return nil
+}
+
+// int64Numbers replaces, in the maps and slices decoded from JSON, the json.Number values
+// holding an integer that fits by an int64, and the others by a float64.
+func int64Numbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = int64Numbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = int64Numbers(e)
+		}
+	}
+	return v
+}
+
+// numberValue returns the exact value of a json.Number: an int64 or a uint64
+// when it fits, or else a big.Rat. Other values, and json.Number values that are
+// not numbers, are returned unchanged.
+func numberValue(value interface{}) interface{} {
+	n, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return i
+	}
+	if u, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		return u
+	}
+	if r, ok := new(big.Rat).SetString(string(n)); ok {
+		return *r
+	}
+	return value
+}
+
 // JsonConvert is a function type that converts any value to a pointer to JSON byte slice.
 // If the conversion fails, it returns nil.
 // This allows for custom conversion logic to be implemented and passed to conversion functions.
@@ -51,7 +167,12 @@ type JsonConvert func(value interface{}) *[]byte
 //	}
 //	customJson := ToJson(myCustomValue, customConverter)
 func ToJson(value interface{}, converters ...JsonConvert) []byte {
-	res, _ := ToJsonE(value, converters...)
+	return std.ToJson(value, converters...)
+}
+
+// ToJson is like the package-level ToJson but uses the options of c.
+func (c *Converter) ToJson(value interface{}, converters ...JsonConvert) []byte {
+	res, _ := c.ToJsonE(value, converters...)
 	return res
 }
 
@@ -86,10 +207,15 @@ func ToJson(value interface{}, converters ...JsonConvert) []byte {
 //	}
 //	customJson := ToJsonOrDefault(myCustomValue, []byte("{}"), customConverter)
 func ToJsonOrDefault(value interface{}, defaultValue []byte, converters ...JsonConvert) []byte {
+	return std.ToJsonOrDefault(value, defaultValue, converters...)
+}
+
+// ToJsonOrDefault is like the package-level ToJsonOrDefault but uses the options of c.
+func (c *Converter) ToJsonOrDefault(value interface{}, defaultValue []byte, converters ...JsonConvert) []byte {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToJsonE(value, converters...)
+	res, err := c.ToJsonE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -125,8 +251,13 @@ func ToJsonOrDefault(value interface{}, defaultValue []byte, converters ...JsonC
 //	}
 //	customJson, err := ToJsonE(myCustomValue, customConverter)
 func ToJsonE(value interface{}, converters ...JsonConvert) ([]byte, error) {
+	return std.ToJsonE(value, converters...)
+}
+
+// ToJsonE is like the package-level ToJsonE but uses the options of c.
+func (c *Converter) ToJsonE(value interface{}, converters ...JsonConvert) ([]byte, error) {
 	if value == nil {
-		return nil, fmt.Errorf("nil value cannot be converted to JSON")
+		return nil, newError(nil, reflect.TypeFor[[]byte](), ErrNil)
 	}
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -169,7 +300,12 @@ func ToJsonE(value interface{}, converters ...JsonConvert) ([]byte, error) {
 //	}
 //	customJson := ToJsonString(myCustomValue, customConverter)
 func ToJsonString(value interface{}, converters ...JsonConvert) string {
-	res, _ := ToJsonStringE(value, converters...)
+	return std.ToJsonString(value, converters...)
+}
+
+// ToJsonString is like the package-level ToJsonString but uses the options of c.
+func (c *Converter) ToJsonString(value interface{}, converters ...JsonConvert) string {
+	res, _ := c.ToJsonStringE(value, converters...)
 	return res
 }
 
@@ -205,10 +341,15 @@ func ToJsonString(value interface{}, converters ...JsonConvert) string {
 //	}
 //	customJson := ToJsonStringOrDefault(myCustomValue, "{}", customConverter)
 func ToJsonStringOrDefault(value interface{}, defaultValue string, converters ...JsonConvert) string {
+	return std.ToJsonStringOrDefault(value, defaultValue, converters...)
+}
+
+// ToJsonStringOrDefault is like the package-level ToJsonStringOrDefault but uses the options of c.
+func (c *Converter) ToJsonStringOrDefault(value interface{}, defaultValue string, converters ...JsonConvert) string {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToJsonStringE(value, converters...)
+	res, err := c.ToJsonStringE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -245,7 +386,12 @@ func ToJsonStringOrDefault(value interface{}, defaultValue string, converters ..
 //	}
 //	customJson, err := ToJsonStringE(myCustomValue, customConverter)
 func ToJsonStringE(value interface{}, converters ...JsonConvert) (string, error) {
-	b, err := ToJsonE(value, converters...)
+	return std.ToJsonStringE(value, converters...)
+}
+
+// ToJsonStringE is like the package-level ToJsonStringE but uses the options of c.
+func (c *Converter) ToJsonStringE(value interface{}, converters ...JsonConvert) (string, error) {
+	b, err := c.ToJsonE(value, converters...)
 	if err != nil {
 		return "", err
 	}
@@ -281,7 +427,12 @@ func ToJsonStringE(value interface{}, converters ...JsonConvert) (string, error)
 //	}
 //	customJson := ToJsonIndent(myCustomValue, customConverter)
 func ToJsonIndent(value interface{}, converters ...JsonConvert) []byte {
-	res, _ := ToJsonIndentE(value, converters...)
+	return std.ToJsonIndent(value, converters...)
+}
+
+// ToJsonIndent is like the package-level ToJsonIndent but uses the options of c.
+func (c *Converter) ToJsonIndent(value interface{}, converters ...JsonConvert) []byte {
+	res, _ := c.ToJsonIndentE(value, converters...)
 	return res
 }
 
@@ -318,10 +469,15 @@ func ToJsonIndent(value interface{}, converters ...JsonConvert) []byte {
 //	}
 //	customJson := ToJsonIndentOrDefault(myCustomValue, []byte("{}"), customConverter)
 func ToJsonIndentOrDefault(value interface{}, defaultValue []byte, converters ...JsonConvert) []byte {
+	return std.ToJsonIndentOrDefault(value, defaultValue, converters...)
+}
+
+// ToJsonIndentOrDefault is like the package-level ToJsonIndentOrDefault but uses the options of c.
+func (c *Converter) ToJsonIndentOrDefault(value interface{}, defaultValue []byte, converters ...JsonConvert) []byte {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToJsonIndentE(value, converters...)
+	res, err := c.ToJsonIndentE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -359,8 +515,13 @@ func ToJsonIndentOrDefault(value interface{}, defaultValue []byte, converters ..
 //	}
 //	customJson, err := ToJsonIndentE(myCustomValue, customConverter)
 func ToJsonIndentE(value interface{}, converters ...JsonConvert) ([]byte, error) {
+	return std.ToJsonIndentE(value, converters...)
+}
+
+// ToJsonIndentE is like the package-level ToJsonIndentE but uses the options of c.
+func (c *Converter) ToJsonIndentE(value interface{}, converters ...JsonConvert) ([]byte, error) {
 	if value == nil {
-		return nil, fmt.Errorf("nil value cannot be converted to JSON")
+		return nil, newError(nil, reflect.TypeFor[[]byte](), ErrNil)
 	}
 
 	for _, converter := range converters {
@@ -406,7 +567,12 @@ func ToJsonIndentE(value interface{}, converters ...JsonConvert) ([]byte, error)
 //	}
 //	customJson := ToJsonIndentString(myCustomValue, customConverter)
 func ToJsonIndentString(value interface{}, converters ...JsonConvert) string {
-	res, _ := ToJsonIndentStringE(value, converters...)
+	return std.ToJsonIndentString(value, converters...)
+}
+
+// ToJsonIndentString is like the package-level ToJsonIndentString but uses the options of c.
+func (c *Converter) ToJsonIndentString(value interface{}, converters ...JsonConvert) string {
+	res, _ := c.ToJsonIndentStringE(value, converters...)
 	return res
 }
 
@@ -444,10 +610,15 @@ func ToJsonIndentString(value interface{}, converters ...JsonConvert) string {
 //	}
 //	customJson := ToJsonIndentStringOrDefault(myCustomValue, "{}", customConverter)
 func ToJsonIndentStringOrDefault(value interface{}, defaultValue string, converters ...JsonConvert) string {
+	return std.ToJsonIndentStringOrDefault(value, defaultValue, converters...)
+}
+
+// ToJsonIndentStringOrDefault is like the package-level ToJsonIndentStringOrDefault but uses the options of c.
+func (c *Converter) ToJsonIndentStringOrDefault(value interface{}, defaultValue string, converters ...JsonConvert) string {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToJsonIndentStringE(value, converters...)
+	res, err := c.ToJsonIndentStringE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -486,7 +657,12 @@ func ToJsonIndentStringOrDefault(value interface{}, defaultValue string, convert
 //	}
 //	customJson, err := ToJsonIndentStringE(myCustomValue, customConverter)
 func ToJsonIndentStringE(value interface{}, converters ...JsonConvert) (string, error) {
-	b, err := ToJsonIndentE(value, converters...)
+	return std.ToJsonIndentStringE(value, converters...)
+}
+
+// ToJsonIndentStringE is like the package-level ToJsonIndentStringE but uses the options of c.
+func (c *Converter) ToJsonIndentStringE(value interface{}, converters ...JsonConvert) (string, error) {
+	b, err := c.ToJsonIndentE(value, converters...)
 	if err != nil {
 		return "", err
 	}
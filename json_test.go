@@ -2,6 +2,8 @@ package convert
 
 import (
 	"encoding/json"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -217,3 +219,71 @@ func TestToJsonIndentStringE(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "", resultNil)
 }
+
+func TestJSONNumberModes(t *testing.T) {
+	const doc = `{"id": 9007199254740993, "price": 1.5, "big": 123456789012345678901, "tags": [1, 2.5]}`
+
+	m, err := ToMapStringInterfaceE(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(9007199254740992), m["id"])
+
+	m, err = New(WithJSONNumbers(JSONNumberUseNumber)).ToMapStringInterfaceE(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, json.Number("9007199254740993"), m["id"])
+	assert.Equal(t, []interface{}{json.Number("1"), json.Number("2.5")}, m["tags"])
+
+	m, err = New(WithJSONNumbers(JSONNumberInt64)).ToMapStringInterfaceE([]byte(doc))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), m["id"])
+	assert.Equal(t, 1.5, m["price"])
+	assert.Equal(t, 1.2345678901234568e20, m["big"])
+	assert.Equal(t, []interface{}{int64(1), 2.5}, m["tags"])
+
+	s, err := New(WithJSONNumbers(JSONNumberInt64)).ToSliceInterfaceE(`[9007199254740993, {"n": 3}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(9007199254740993), map[string]interface{}{"n": int64(3)}}, s)
+
+	_, err = New(WithJSONNumbers(JSONNumberUseNumber)).ToSliceInterfaceE(`[1]]`)
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	assert.Equal(t, "int64", JSONNumberInt64.String())
+}
+
+func TestJSONNumberInputs(t *testing.T) {
+	n, err := ToInt64E(json.Number("9007199254740993"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), n)
+
+	u, err := ToUint64E(json.Number("18446744073709551615"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), u)
+
+	_, err = ToUint64E(json.Number("18446744073709551616"))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	n, err = ToInt64E(json.Number("9007199254740993e0"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), n)
+
+	i, err := ToIntE(json.Number("2.5"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, i)
+
+	_, err = Strict().ToIntE(json.Number("2.5"))
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	b, err := ToBigIntE(json.Number("123456789012345678901234567890"))
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789012345678901234567890", b.String())
+
+	r, err := ToBigRatE(json.Number("0.1"))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Cmp(big.NewRat(1, 10)))
+
+	_, err = ToInt64E(json.Number("abc"))
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	m, err := New(WithJSONNumbers(JSONNumberUseNumber)).ToMapStringInterfaceE(`{"id": 9007199254740993}`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), ToInt64(m["id"]))
+}
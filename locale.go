@@ -0,0 +1,280 @@
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NumberFormat describes how numbers are written in a locale, for parsing
+// strings like "1,234.56", "1.234,56 €" or "1 234,56".
+type NumberFormat struct {
+	// Decimal is the decimal separator. The zero value means '.'.
+	Decimal rune
+
+	// Grouping holds the accepted digit grouping separators, one per rune,
+	// like "," or " \u00a0" for spaces and non-breaking spaces.
+	// Groups after the first one must have three digits.
+	Grouping string
+
+	// Currencies are the currency codes or symbols, like "EUR" or "CHF", that may precede
+	// or follow the number. Unicode currency symbols, like "$" or "€", are always accepted.
+	Currencies []string
+}
+
+var (
+	// NumberFormatEnglish writes numbers like "1,234.56".
+	NumberFormatEnglish = NumberFormat{Decimal: '.', Grouping: ","}
+
+	// NumberFormatGerman writes numbers like "1.234,56".
+	NumberFormatGerman = NumberFormat{Decimal: ',', Grouping: "."}
+
+	// NumberFormatFrench writes numbers like "1 234,56", with a space,
+	// a non-breaking space or a narrow non-breaking space between groups.
+	NumberFormatFrench = NumberFormat{Decimal: ',', Grouping: " \u00a0\u202f"}
+
+	// NumberFormatSwiss writes numbers like "1'234.56".
+	NumberFormatSwiss = NumberFormat{Decimal: '.', Grouping: "'\u2019", Currencies: []string{"CHF", "Fr."}}
+)
+
+// localeNumberFormats maps languages and locales to their number format.
+// Locales are looked up before their language.
+var localeNumberFormats = map[string]NumberFormat{
+	"en": NumberFormatEnglish,
+	"ja": NumberFormatEnglish,
+	"ko": NumberFormatEnglish,
+	"zh": NumberFormatEnglish,
+	"he": NumberFormatEnglish,
+	"th": NumberFormatEnglish,
+
+	"de":    NumberFormatGerman,
+	"es":    NumberFormatGerman,
+	"it":    NumberFormatGerman,
+	"nl":    NumberFormatGerman,
+	"pt":    NumberFormatGerman,
+	"da":    NumberFormatGerman,
+	"id":    NumberFormatGerman,
+	"tr":    NumberFormatGerman,
+	"el":    NumberFormatGerman,
+	"ro":    NumberFormatGerman,
+	"es-mx": NumberFormatEnglish,
+	"es-us": NumberFormatEnglish,
+
+	"fr":    NumberFormatFrench,
+	"ru":    NumberFormatFrench,
+	"uk":    NumberFormatFrench,
+	"pl":    NumberFormatFrench,
+	"cs":    NumberFormatFrench,
+	"sk":    NumberFormatFrench,
+	"hu":    NumberFormatFrench,
+	"sv":    NumberFormatFrench,
+	"fi":    NumberFormatFrench,
+	"nb":    NumberFormatFrench,
+	"no":    NumberFormatFrench,
+	"pt-pt": NumberFormatFrench,
+
+	"de-ch": NumberFormatSwiss,
+	"de-li": NumberFormatSwiss,
+	"it-ch": NumberFormatSwiss,
+}
+
+// NumberFormatFor returns the number format of a locale, like "de-DE", "fr_CA" or "en".
+// Unknown regions fall back to the format of their language.
+func NumberFormatFor(locale string) (NumberFormat, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if f, ok := localeNumberFormats[tag]; ok {
+		return f, true
+	}
+	lang, _, _ := strings.Cut(tag, "-")
+	f, ok := localeNumberFormats[lang]
+	return f, ok
+}
+
+// WithNumberFormat makes the integer, float, big number and Decimal converters parse
+// strings written in the number format f: grouping separators are removed, the decimal
+// separator is read as a point, and surrounding spaces and currency symbols are ignored.
+// Strings with other characters, like "0x1F" or "true", are parsed as usual.
+// By default, numbers are parsed as Go literals, without grouping or currency.
+//
+// Example:
+//
+//	f, _ := convert.NumberFormatFor("de-DE")
+//	csv := convert.New(convert.WithNumberFormat(f))
+//	price, err := csv.ToFloat64E("1.234,56 €") // 1234.56
+func WithNumberFormat(f NumberFormat) Option {
+	return func(o *options) {
+		o.numberFormat = &f
+	}
+}
+
+// ToFloat64LocaleE converts any type of value to float64 or returns an error,
+// parsing strings in the number format of locale, as returned by NumberFormatFor.
+// Unknown locales are reported as ErrUnsupported.
+//
+// Example:
+//
+//	f, err := convert.ToFloat64LocaleE("1 234,56", "fr-FR")
+//	fmt.Println(f) // Output: 1234.56
+func ToFloat64LocaleE(value interface{}, locale string) (float64, error) {
+	return std.ToFloat64LocaleE(value, locale)
+}
+
+// ToFloat64LocaleE is like the package-level ToFloat64LocaleE but uses the options of c.
+func (c *Converter) ToFloat64LocaleE(value interface{}, locale string) (float64, error) {
+	f, ok := NumberFormatFor(locale)
+	if !ok {
+		return 0, newError(value, reflect.TypeFor[float64](), fmt.Errorf("%w: unknown locale %q", ErrUnsupported, locale))
+	}
+	return c.With(WithNumberFormat(f)).ToFloat64E(value)
+}
+
+// localNumber rewrites a string, or a []byte, written in the number format of c
+// as a Go number literal. With WithFractions, percentages and fractions are
+// returned as the value given by ratioValue. Other values are returned unchanged.
+func (c *Converter) localNumber(value interface{}, to reflect.Type) (interface{}, error) {
+	if c.opts.numberFormat == nil && !c.opts.fractions {
+		return value, nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return value, nil
+	}
+
+	if c.opts.fractions {
+		r, ok, err := c.parseRatio(s)
+		if err != nil {
+			return nil, newError(value, to, err)
+		}
+		if ok {
+			return ratioValue(value, r, to)
+		}
+	}
+	if c.opts.numberFormat == nil {
+		return value, nil
+	}
+
+	res, ok, err := c.opts.numberFormat.normalize(s)
+	if err != nil {
+		return nil, newError(value, to, err)
+	}
+	if !ok {
+		return value, nil
+	}
+	return res, nil
+}
+
+// normalize rewrites s as a Go number literal. It reports false when s holds characters
+// that cannot appear in a number of the format f, and ErrSyntax when s looks like
+// such a number but is malformed, like "1,23,4".
+func (f NumberFormat) normalize(s string) (string, bool, error) {
+	body := strings.TrimSpace(s)
+	if body == "" {
+		return s, true, nil
+	}
+
+	sign := ""
+	body, currency := f.trimCurrency(body)
+	if body != "" && (body[0] == '-' || body[0] == '+') {
+		sign, body = body[:1], strings.TrimSpace(body[1:])
+	}
+	if !currency {
+		body, _ = f.trimCurrency(body)
+	}
+
+	decimal := f.Decimal
+	if decimal == 0 {
+		decimal = '.'
+	}
+
+	for _, r := range body {
+		if !('0' <= r && r <= '9') && r != decimal && r != 'e' && r != 'E' && r != '+' && r != '-' &&
+			!strings.ContainsRune(f.Grouping, r) {
+			return s, false, nil
+		}
+	}
+
+	mantissa, exponent, hasExponent := strings.Cut(strings.ReplaceAll(body, "E", "e"), "e")
+	intPart, fracPart, hasFrac := strings.Cut(mantissa, string(decimal))
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	if !f.writeGroups(&sb, intPart) || !isDigits(fracPart) || (intPart == "" && fracPart == "") {
+		return "", false, ErrSyntax
+	}
+	if hasFrac {
+		sb.WriteByte('.')
+		sb.WriteString(fracPart)
+	}
+	if hasExponent {
+		digits := strings.TrimLeft(exponent, "+-")
+		if len(exponent)-len(digits) > 1 || digits == "" || !isDigits(digits) {
+			return "", false, ErrSyntax
+		}
+		sb.WriteByte('e')
+		sb.WriteString(exponent)
+	}
+	return sb.String(), true, nil
+}
+
+// trimCurrency removes one currency code or symbol, and the spaces around it,
+// from the start or the end of s.
+func (f NumberFormat) trimCurrency(s string) (string, bool) {
+	for _, cur := range f.Currencies {
+		if cur == "" {
+			continue
+		}
+		if strings.HasPrefix(s, cur) {
+			return strings.TrimSpace(s[len(cur):]), true
+		}
+		if strings.HasSuffix(s, cur) {
+			return strings.TrimSpace(s[:len(s)-len(cur)]), true
+		}
+	}
+	if r, size := utf8.DecodeRuneInString(s); unicode.Is(unicode.Sc, r) {
+		return strings.TrimSpace(s[size:]), true
+	}
+	if r, size := utf8.DecodeLastRuneInString(s); unicode.Is(unicode.Sc, r) {
+		return strings.TrimSpace(s[:len(s)-size]), true
+	}
+	return s, false
+}
+
+// writeGroups writes the digits of the integer part s to sb, without its grouping
+// separators. It reports false if the groups are malformed.
+func (f NumberFormat) writeGroups(sb *strings.Builder, s string) bool {
+	groups := strings.FieldsFunc(s, func(r rune) bool {
+		return strings.ContainsRune(f.Grouping, r)
+	})
+	if len(groups) == 0 {
+		return s == ""
+	}
+	// Every separator must sit between two groups, like in "1,234" but not "1,,234" or ",234".
+	if utf8.RuneCountInString(s) != len(strings.Join(groups, ""))+len(groups)-1 {
+		return false
+	}
+	for i, g := range groups {
+		if !isDigits(g) || (i == 0 && len(g) > 3 && len(groups) > 1) || (i > 0 && len(g) != 3) {
+			return false
+		}
+		sb.WriteString(g)
+	}
+	return true
+}
+
+// isDigits reports whether s only holds ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberFormatFor(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected NumberFormat
+		ok       bool
+	}{
+		{"en", NumberFormatEnglish, true},
+		{"en-US", NumberFormatEnglish, true},
+		{"de_DE", NumberFormatGerman, true},
+		{"es-MX", NumberFormatEnglish, true},
+		{"fr-CA", NumberFormatFrench, true},
+		{"de-CH", NumberFormatSwiss, true},
+		{"xx-YY", NumberFormat{}, false},
+	}
+
+	for _, test := range tests {
+		f, ok := NumberFormatFor(test.locale)
+		assert.Equal(t, test.ok, ok, test.locale)
+		assert.Equal(t, test.expected, f, test.locale)
+	}
+}
+
+func TestWithNumberFormat(t *testing.T) {
+	tests := []struct {
+		format   NumberFormat
+		input    interface{}
+		expected float64
+		err      error
+	}{
+		{NumberFormatEnglish, "1,234.56", 1234.56, nil},
+		{NumberFormatEnglish, "  $1,234,567.5 ", 1234567.5, nil},
+		{NumberFormatEnglish, "-$12.50", -12.5, nil},
+		{NumberFormatEnglish, "$-12.50", -12.5, nil},
+		{NumberFormatEnglish, "1.5e3", 1500, nil},
+		{NumberFormatEnglish, []byte("£1,000"), 1000, nil},
+		{NumberFormatEnglish, "0x1p4", 16, nil},
+		{NumberFormatEnglish, "1,5", 0, ErrSyntax},
+		{NumberFormatEnglish, "1,2345", 0, ErrSyntax},
+		{NumberFormatEnglish, ",123", 0, ErrSyntax},
+		{NumberFormatEnglish, "1,,234", 0, ErrSyntax},
+		{NumberFormatEnglish, "$", 0, ErrSyntax},
+		{NumberFormatGerman, "1.234,56", 1234.56, nil},
+		{NumberFormatGerman, "1.234,56 €", 1234.56, nil},
+		{NumberFormatGerman, "-0,5", -0.5, nil},
+		{NumberFormatGerman, "1.5", 0, ErrSyntax},
+		{NumberFormatFrench, "1 234,56", 1234.56, nil},
+		{NumberFormatFrench, "1 234 567,8 €", 1234567.8, nil},
+		{NumberFormatFrench, "1 000", 1000, nil},
+		{NumberFormatSwiss, "1'234.50", 1234.5, nil},
+		{NumberFormatSwiss, "CHF 1’234.50", 1234.5, nil},
+		{NumberFormatSwiss, "-1'000 Fr.", -1000, nil},
+		{NumberFormat{Decimal: ',', Grouping: ".", Currencies: []string{"EUR"}}, "EUR 9,99", 9.99, nil},
+		{NumberFormatGerman, "abc", 0, ErrSyntax},
+	}
+
+	for _, test := range tests {
+		c := New(WithNumberFormat(test.format))
+		res, err := c.ToFloat64E(test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %q", test.input)
+			continue
+		}
+		if assert.NoError(t, err, "input %q", test.input) {
+			assert.Equal(t, test.expected, res, "input %q", test.input)
+		}
+	}
+}
+
+func TestNumberFormatConverters(t *testing.T) {
+	us := New(WithNumberFormat(NumberFormatEnglish))
+	de := New(WithNumberFormat(NumberFormatGerman))
+
+	assert.Equal(t, 1000, us.ToInt("1,000"))
+	assert.Equal(t, int64(-1234567), de.ToInt64("-1.234.567"))
+	assert.Equal(t, uint16(1234), de.ToUint16("1.234,00"))
+	assert.Equal(t, float32(2.5), de.ToFloat32("2,5"))
+	assert.Equal(t, 1, de.ToInt("1,9"))
+	assert.Equal(t, "1234.56", de.ToDecimal("1.234,56 €").String())
+	assert.Equal(t, "123456789012345678901234567890", us.ToBigInt("123,456,789,012,345,678,901,234,567,890").String())
+	assert.Equal(t, true, de.ToBool("true"))
+	assert.Equal(t, 31, de.ToInt("0x1F"))
+
+	_, err := us.ToUint8E("1,000")
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = us.With(WithStrict(true)).ToIntE("1,000.5")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = ToIntE("1,000")
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	s, err := de.ToSliceIntE([]string{"1.000", "2.000"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1000, 2000}, s)
+
+	var row struct {
+		Price float64 `convert:"price"`
+	}
+	assert.NoError(t, de.Decode(map[string]interface{}{"price": "12,99 €"}, &row))
+	assert.Equal(t, 12.99, row.Price)
+}
+
+func TestToFloat64LocaleE(t *testing.T) {
+	f, err := ToFloat64LocaleE("1 234,56", "fr-FR")
+	assert.NoError(t, err)
+	assert.Equal(t, 1234.56, f)
+
+	f, err = ToFloat64LocaleE("1.234,56", "pt-BR")
+	assert.NoError(t, err)
+	assert.Equal(t, 1234.56, f)
+
+	f, err = ToFloat64LocaleE(3, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, f)
+
+	_, err = ToFloat64LocaleE("1", "tlh")
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
@@ -3,6 +3,8 @@ package convert
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"reflect"
 	"time"
 )
 
@@ -125,6 +127,18 @@ type MapStringFloat32Converter func(value interface{}) *map[string]float32
 //	convertedValue := ToMapStringFloat64(someValue, customMapStringFloat64Converter)
 type MapStringFloat64Converter func(value interface{}) *map[string]float64
 
+// MapStringBigIntConverter est un type de fonction pour la conversion personnalisée de map[string]*big.Int.
+// Elle prend n'importe quelle valeur et retourne un pointeur vers une map[string]*big.Int si la conversion réussit, ou nil si elle échoue.
+type MapStringBigIntConverter func(value interface{}) *map[string]*big.Int
+
+// MapStringBigFloatConverter est un type de fonction pour la conversion personnalisée de map[string]*big.Float.
+// Elle prend n'importe quelle valeur et retourne un pointeur vers une map[string]*big.Float si la conversion réussit, ou nil si elle échoue.
+type MapStringBigFloatConverter func(value interface{}) *map[string]*big.Float
+
+// MapStringBigRatConverter est un type de fonction pour la conversion personnalisée de map[string]*big.Rat.
+// Elle prend n'importe quelle valeur et retourne un pointeur vers une map[string]*big.Rat si la conversion réussit, ou nil si elle échoue.
+type MapStringBigRatConverter func(value interface{}) *map[string]*big.Rat
+
 // MapStringTimeConverter est un type de fonction pour la conversion personnalisée de map[string]time.Time.
 // Elle prend n'importe quelle valeur et retourne un pointeur vers une map[string]time.Time si la conversion réussit, ou nil si elle échoue.
 // Cela permet une logique de conversion flexible et définie par l'utilisateur.
@@ -159,6 +173,23 @@ type MapStringTimeConverter func(value interface{}) *map[string]time.Time
 //	convertedValue := ToMapStringDuration(someValue, customMapStringDurationConverter)
 type MapStringDurationConverter func(value interface{}) *map[string]time.Duration
 
+// MapStringByteSizeConverter est un type de fonction pour la conversion personnalisée de map[string]ByteSize.
+// Elle prend n'importe quelle valeur et retourne un pointeur vers une map[string]ByteSize si la conversion réussit, ou nil si elle échoue.
+// Cela permet une logique de conversion flexible et définie par l'utilisateur.
+//
+// Exemple d'utilisation :
+//
+//	customMapStringByteSizeConverter := func(value interface{}) *map[string]ByteSize {
+//		if customVal, ok := value.(CustomType); ok {
+//			result := customVal.ToMapStringByteSize()
+//			return &result
+//		}
+//		return nil
+//	}
+//
+//	convertedValue := ToMapStringByteSize(someValue, customMapStringByteSizeConverter)
+type MapStringByteSizeConverter func(value interface{}) *map[string]ByteSize
+
 // MapStringInterfaceConverter est un type de fonction pour la conversion personnalisée de map[string]interface{}.
 // Elle prend n'importe quelle valeur et retourne un pointeur vers une map[string]interface{} si la conversion réussit, ou nil si elle échoue.
 // Cela permet une logique de conversion flexible et définie par l'utilisateur.
@@ -192,7 +223,12 @@ type MapStringInterfaceConverter func(value interface{}) *map[string]interface{}
 //
 //	convertedValue := ToMapStringString(someValue, customConverter)
 func ToMapStringString(value interface{}, converters ...MapStringStringConverter) map[string]string {
-	res, _ := ToMapStringStringE(value, converters...)
+	return std.ToMapStringString(value, converters...)
+}
+
+// ToMapStringString is like the package-level ToMapStringString but uses the options of c.
+func (c *Converter) ToMapStringString(value interface{}, converters ...MapStringStringConverter) map[string]string {
+	res, _ := c.ToMapStringStringE(value, converters...)
 	return res
 }
 
@@ -213,10 +249,15 @@ func ToMapStringString(value interface{}, converters ...MapStringStringConverter
 //	defaultValue := map[string]string{"default": "value"}
 //	convertedValue := ToMapStringStringOrDefault(someValue, defaultValue, customConverter)
 func ToMapStringStringOrDefault(value interface{}, defaultValue map[string]string, converters ...MapStringStringConverter) map[string]string {
+	return std.ToMapStringStringOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringStringOrDefault is like the package-level ToMapStringStringOrDefault but uses the options of c.
+func (c *Converter) ToMapStringStringOrDefault(value interface{}, defaultValue map[string]string, converters ...MapStringStringConverter) map[string]string {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringStringE(value, converters...)
+	res, _ := c.ToMapStringStringE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -239,8 +280,17 @@ func ToMapStringStringOrDefault(value interface{}, defaultValue map[string]strin
 //   - string, []byte: map[string]string{value: value}
 //   - map[string]string: value
 //   - map[string]interface{}, map[interface{}]string, map[interface{}]interface{}: convert all keys and values to string
+//   - struct, *struct: convert the fields to a map as ToMapStringInterfaceE does, then all values to string
 func ToMapStringStringE(value interface{}, converters ...MapStringStringConverter) (map[string]string, error) {
-	i := Indirect(value)
+	return std.ToMapStringStringE(value, converters...)
+}
+
+// ToMapStringStringE is like the package-level ToMapStringStringE but uses the options of c.
+func (c *Converter) ToMapStringStringE(value interface{}, converters ...MapStringStringConverter) (map[string]string, error) {
+	i, err := indirectValue(value, reflect.TypeFor[map[string]string]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -248,12 +298,16 @@ func ToMapStringStringE(value interface{}, converters ...MapStringStringConverte
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]string](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case string:
 		var res map[string]string
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]string](), syntaxError(err))
 		}
 		return res, nil
 
@@ -261,22 +315,18 @@ func ToMapStringStringE(value interface{}, converters ...MapStringStringConverte
 		var res map[string]string
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]string](), syntaxError(err))
 		}
 		return res, nil
 
 	case map[string]string:
 		return v, nil
 
-	case map[string]interface{}, map[interface{}]string, map[interface{}]interface{}:
-		res := make(map[string]string)
-		for key, val := range i.(map[interface{}]interface{}) {
-			res[ToString(key)] = ToString(val)
-		}
-		return res, nil
-
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (string, error) { return c.ToStringE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]string]())
 	}
 }
 
@@ -296,7 +346,12 @@ func ToMapStringStringE(value interface{}, converters ...MapStringStringConverte
 //
 //	valeurConvertie := ToMapStringSliceString(uneValeur, convertisseurPersonnalise)
 func ToMapStringSliceString(value interface{}, converters ...MapStringSliceStringConverter) map[string][]string {
-	res, _ := ToMapStringSliceStringE(value, converters...)
+	return std.ToMapStringSliceString(value, converters...)
+}
+
+// ToMapStringSliceString is like the package-level ToMapStringSliceString but uses the options of c.
+func (c *Converter) ToMapStringSliceString(value interface{}, converters ...MapStringSliceStringConverter) map[string][]string {
+	res, _ := c.ToMapStringSliceStringE(value, converters...)
 	return res
 }
 
@@ -317,10 +372,15 @@ func ToMapStringSliceString(value interface{}, converters ...MapStringSliceStrin
 //	valeurParDefaut := map[string][]string{"cle": {"valeur1", "valeur2"}}
 //	valeurConvertie := ToMapStringSliceStringOrDefault(uneValeur, valeurParDefaut, convertisseurPersonnalise)
 func ToMapStringSliceStringOrDefault(value interface{}, defaultValue map[string][]string, converters ...MapStringSliceStringConverter) map[string][]string {
+	return std.ToMapStringSliceStringOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringSliceStringOrDefault is like the package-level ToMapStringSliceStringOrDefault but uses the options of c.
+func (c *Converter) ToMapStringSliceStringOrDefault(value interface{}, defaultValue map[string][]string, converters ...MapStringSliceStringConverter) map[string][]string {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringSliceStringE(value, converters...)
+	res, _ := c.ToMapStringSliceStringE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -347,7 +407,15 @@ func ToMapStringSliceStringOrDefault(value interface{}, defaultValue map[string]
 //		// Gérer l'erreur
 //	}
 func ToMapStringSliceStringE(value interface{}, converters ...MapStringSliceStringConverter) (map[string][]string, error) {
-	i := Indirect(value)
+	return std.ToMapStringSliceStringE(value, converters...)
+}
+
+// ToMapStringSliceStringE is like the package-level ToMapStringSliceStringE but uses the options of c.
+func (c *Converter) ToMapStringSliceStringE(value interface{}, converters ...MapStringSliceStringConverter) (map[string][]string, error) {
+	i, err := indirectValue(value, reflect.TypeFor[map[string][]string]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -355,12 +423,16 @@ func ToMapStringSliceStringE(value interface{}, converters ...MapStringSliceStri
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string][]string](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case string:
 		var res map[string][]string
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string][]string](), syntaxError(err))
 		}
 		return res, nil
 
@@ -368,34 +440,18 @@ func ToMapStringSliceStringE(value interface{}, converters ...MapStringSliceStri
 		var res map[string][]string
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string][]string](), syntaxError(err))
 		}
 		return res, nil
 
 	case map[string][]string:
 		return v, nil
 
-	case map[string]interface{}, map[interface{}]string, map[interface{}]interface{}:
-		res := make(map[string][]string)
-		for key, val := range i.(map[interface{}]interface{}) {
-			strKey := ToString(key)
-			switch sliceVal := val.(type) {
-			case []string:
-				res[strKey] = sliceVal
-			case []interface{}:
-				strSlice := make([]string, len(sliceVal))
-				for i, v := range sliceVal {
-					strSlice[i] = ToString(v)
-				}
-				res[strKey] = strSlice
-			default:
-				res[strKey] = []string{ToString(val)}
-			}
-		}
-		return res, nil
-
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) ([]string, error) { return c.ToStringArrayE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string][]string]())
 	}
 }
 
@@ -415,7 +471,12 @@ func ToMapStringSliceStringE(value interface{}, converters ...MapStringSliceStri
 //
 //	convertedValue := ToMapStringBool(someValue, customConverter)
 func ToMapStringBool(value interface{}, converters ...MapStringBoolConverter) map[string]bool {
-	res, _ := ToMapStringBoolE(value, converters...)
+	return std.ToMapStringBool(value, converters...)
+}
+
+// ToMapStringBool is like the package-level ToMapStringBool but uses the options of c.
+func (c *Converter) ToMapStringBool(value interface{}, converters ...MapStringBoolConverter) map[string]bool {
+	res, _ := c.ToMapStringBoolE(value, converters...)
 	return res
 }
 
@@ -436,10 +497,15 @@ func ToMapStringBool(value interface{}, converters ...MapStringBoolConverter) ma
 //	defaultValue := map[string]bool{"default": true}
 //	convertedValue := ToMapStringBoolOrDefault(someValue, defaultValue, customConverter)
 func ToMapStringBoolOrDefault(value interface{}, defaultValue map[string]bool, converters ...MapStringBoolConverter) map[string]bool {
+	return std.ToMapStringBoolOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringBoolOrDefault is like the package-level ToMapStringBoolOrDefault but uses the options of c.
+func (c *Converter) ToMapStringBoolOrDefault(value interface{}, defaultValue map[string]bool, converters ...MapStringBoolConverter) map[string]bool {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringBoolE(value, converters...)
+	res, _ := c.ToMapStringBoolE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -474,11 +540,19 @@ func ToMapStringBoolOrDefault(value interface{}, defaultValue map[string]bool, c
 //		// Gérer l'erreur
 //	}
 func ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (map[string]bool, error) {
+	return std.ToMapStringBoolE(value, converters...)
+}
+
+// ToMapStringBoolE is like the package-level ToMapStringBoolE but uses the options of c.
+func (c *Converter) ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (map[string]bool, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]bool]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -486,6 +560,10 @@ func ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]bool](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]bool:
 		return v, nil
@@ -493,9 +571,9 @@ func ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (
 	case map[string]interface{}:
 		res := make(map[string]bool)
 		for key, val := range v {
-			boolValue, err := ToBoolE(val)
+			boolValue, err := c.ToBoolE(val)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en bool : %v", key, err)
+				return nil, pathError(err, keyPath(key), val, reflect.TypeFor[bool]())
 			}
 			res[key] = boolValue
 		}
@@ -505,7 +583,7 @@ func ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (
 		var res map[string]bool
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]bool](), syntaxError(err))
 		}
 		return res, nil
 
@@ -513,12 +591,15 @@ func ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (
 		var res map[string]bool
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]bool](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (bool, error) { return c.ToBoolE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]bool]())
 	}
 }
 
@@ -538,7 +619,12 @@ func ToMapStringBoolE(value interface{}, converters ...MapStringBoolConverter) (
 //
 //	convertedValue := ToMapStringInt(someValue, customConverter)
 func ToMapStringInt(value interface{}, converters ...MapStringIntConverter) map[string]int {
-	res, _ := ToMapStringIntE(value, converters...)
+	return std.ToMapStringInt(value, converters...)
+}
+
+// ToMapStringInt is like the package-level ToMapStringInt but uses the options of c.
+func (c *Converter) ToMapStringInt(value interface{}, converters ...MapStringIntConverter) map[string]int {
+	res, _ := c.ToMapStringIntE(value, converters...)
 	return res
 }
 
@@ -559,10 +645,15 @@ func ToMapStringInt(value interface{}, converters ...MapStringIntConverter) map[
 //	defaultValue := map[string]int{"default": 0}
 //	convertedValue := ToMapStringIntOrDefault(someValue, defaultValue, customConverter)
 func ToMapStringIntOrDefault(value interface{}, defaultValue map[string]int, converters ...MapStringIntConverter) map[string]int {
+	return std.ToMapStringIntOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringIntOrDefault is like the package-level ToMapStringIntOrDefault but uses the options of c.
+func (c *Converter) ToMapStringIntOrDefault(value interface{}, defaultValue map[string]int, converters ...MapStringIntConverter) map[string]int {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringIntE(value, converters...)
+	res, _ := c.ToMapStringIntE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -598,11 +689,19 @@ func ToMapStringIntOrDefault(value interface{}, defaultValue map[string]int, con
 //		// Gérer l'erreur
 //	}
 func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (map[string]int, error) {
+	return std.ToMapStringIntE(value, converters...)
+}
+
+// ToMapStringIntE is like the package-level ToMapStringIntE but uses the options of c.
+func (c *Converter) ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (map[string]int, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]int]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -610,6 +709,10 @@ func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (ma
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]int](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]int:
 		return v, nil
@@ -617,13 +720,13 @@ func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (ma
 	case map[interface{}]interface{}:
 		res := make(map[string]int)
 		for k, v := range v {
-			key, err := ToStringE(k)
+			key, err := c.plainString(k)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la clé en string : %v", err)
+				return nil, pathError(err, keyPath(fmt.Sprint(k)), k, reflect.TypeFor[string]())
 			}
-			intValue, err := ToIntE(v)
+			intValue, err := c.ToIntE(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en int : %v", key, err)
+				return nil, pathError(err, keyPath(key), v, reflect.TypeFor[int]())
 			}
 			res[key] = intValue
 		}
@@ -632,9 +735,9 @@ func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (ma
 	case map[string]interface{}:
 		res := make(map[string]int)
 		for k, v := range v {
-			intValue, err := ToIntE(v)
+			intValue, err := c.ToIntE(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en int : %v", k, err)
+				return nil, pathError(err, keyPath(k), v, reflect.TypeFor[int]())
 			}
 			res[k] = intValue
 		}
@@ -644,7 +747,7 @@ func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (ma
 		var res map[string]int
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]int](), syntaxError(err))
 		}
 		return res, nil
 
@@ -652,12 +755,15 @@ func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (ma
 		var res map[string]int
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]int](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (int, error) { return c.ToIntE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]int]())
 	}
 }
 
@@ -677,7 +783,12 @@ func ToMapStringIntE(value interface{}, converters ...MapStringIntConverter) (ma
 //
 //	convertedValue := ToMapStringInt64(someValue, customConverter)
 func ToMapStringInt64(value interface{}, converters ...MapStringInt64Converter) map[string]int64 {
-	res, _ := ToMapStringInt64E(value, converters...)
+	return std.ToMapStringInt64(value, converters...)
+}
+
+// ToMapStringInt64 is like the package-level ToMapStringInt64 but uses the options of c.
+func (c *Converter) ToMapStringInt64(value interface{}, converters ...MapStringInt64Converter) map[string]int64 {
+	res, _ := c.ToMapStringInt64E(value, converters...)
 	return res
 }
 
@@ -698,10 +809,15 @@ func ToMapStringInt64(value interface{}, converters ...MapStringInt64Converter)
 //	defaultValue := map[string]int64{"default": 42}
 //	convertedValue := ToMapStringInt64OrDefault(someValue, defaultValue, customConverter)
 func ToMapStringInt64OrDefault(value interface{}, defaultValue map[string]int64, converters ...MapStringInt64Converter) map[string]int64 {
+	return std.ToMapStringInt64OrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringInt64OrDefault is like the package-level ToMapStringInt64OrDefault but uses the options of c.
+func (c *Converter) ToMapStringInt64OrDefault(value interface{}, defaultValue map[string]int64, converters ...MapStringInt64Converter) map[string]int64 {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringInt64E(value, converters...)
+	res, _ := c.ToMapStringInt64E(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -736,11 +852,19 @@ func ToMapStringInt64OrDefault(value interface{}, defaultValue map[string]int64,
 //		// Gérer l'erreur
 //	}
 func ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter) (map[string]int64, error) {
+	return std.ToMapStringInt64E(value, converters...)
+}
+
+// ToMapStringInt64E is like the package-level ToMapStringInt64E but uses the options of c.
+func (c *Converter) ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter) (map[string]int64, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]int64]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -748,6 +872,10 @@ func ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter)
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]int64](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]int64:
 		return v, nil
@@ -755,9 +883,9 @@ func ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter)
 	case map[string]interface{}:
 		res := make(map[string]int64)
 		for k, v := range v {
-			int64Value, err := ToInt64E(v)
+			int64Value, err := c.ToInt64E(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en int64 : %v", k, err)
+				return nil, pathError(err, keyPath(k), v, reflect.TypeFor[int64]())
 			}
 			res[k] = int64Value
 		}
@@ -767,7 +895,7 @@ func ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter)
 		var res map[string]int64
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]int64](), syntaxError(err))
 		}
 		return res, nil
 
@@ -775,12 +903,15 @@ func ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter)
 		var res map[string]int64
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]int64](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (int64, error) { return c.ToInt64E(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]int64]())
 	}
 }
 
@@ -800,7 +931,12 @@ func ToMapStringInt64E(value interface{}, converters ...MapStringInt64Converter)
 //
 //	convertedValue := ToMapStringFloat32(someValue, customConverter)
 func ToMapStringFloat32(value interface{}, converters ...MapStringFloat32Converter) map[string]float32 {
-	res, _ := ToMapStringFloat32E(value, converters...)
+	return std.ToMapStringFloat32(value, converters...)
+}
+
+// ToMapStringFloat32 is like the package-level ToMapStringFloat32 but uses the options of c.
+func (c *Converter) ToMapStringFloat32(value interface{}, converters ...MapStringFloat32Converter) map[string]float32 {
+	res, _ := c.ToMapStringFloat32E(value, converters...)
 	return res
 }
 
@@ -821,10 +957,15 @@ func ToMapStringFloat32(value interface{}, converters ...MapStringFloat32Convert
 //	defaultValue := map[string]float32{"default": 0.0}
 //	convertedValue := ToMapStringFloat32OrDefault(someValue, defaultValue, customConverter)
 func ToMapStringFloat32OrDefault(value interface{}, defaultValue map[string]float32, converters ...MapStringFloat32Converter) map[string]float32 {
+	return std.ToMapStringFloat32OrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringFloat32OrDefault is like the package-level ToMapStringFloat32OrDefault but uses the options of c.
+func (c *Converter) ToMapStringFloat32OrDefault(value interface{}, defaultValue map[string]float32, converters ...MapStringFloat32Converter) map[string]float32 {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringFloat32E(value, converters...)
+	res, _ := c.ToMapStringFloat32E(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -862,11 +1003,19 @@ func ToMapStringFloat32OrDefault(value interface{}, defaultValue map[string]floa
 //		// Gérer l'erreur
 //	}
 func ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Converter) (map[string]float32, error) {
+	return std.ToMapStringFloat32E(value, converters...)
+}
+
+// ToMapStringFloat32E is like the package-level ToMapStringFloat32E but uses the options of c.
+func (c *Converter) ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Converter) (map[string]float32, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]float32]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -874,6 +1023,10 @@ func ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Conver
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]float32](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]float32:
 		return v, nil
@@ -881,9 +1034,9 @@ func ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Conver
 	case map[string]interface{}:
 		res := make(map[string]float32)
 		for k, v := range v {
-			float32Value, err := ToFloat32E(v)
+			float32Value, err := c.ToFloat32E(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en float32 : %v", k, err)
+				return nil, pathError(err, keyPath(k), v, reflect.TypeFor[float32]())
 			}
 			res[k] = float32Value
 		}
@@ -893,7 +1046,7 @@ func ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Conver
 		var res map[string]float32
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]float32](), syntaxError(err))
 		}
 		return res, nil
 
@@ -901,12 +1054,15 @@ func ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Conver
 		var res map[string]float32
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]float32](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (float32, error) { return c.ToFloat32E(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]float32]())
 	}
 }
 
@@ -926,7 +1082,12 @@ func ToMapStringFloat32E(value interface{}, converters ...MapStringFloat32Conver
 //
 //	convertedValue := ToMapStringFloat64(someValue, customConverter)
 func ToMapStringFloat64(value interface{}, converters ...MapStringFloat64Converter) map[string]float64 {
-	res, _ := ToMapStringFloat64E(value, converters...)
+	return std.ToMapStringFloat64(value, converters...)
+}
+
+// ToMapStringFloat64 is like the package-level ToMapStringFloat64 but uses the options of c.
+func (c *Converter) ToMapStringFloat64(value interface{}, converters ...MapStringFloat64Converter) map[string]float64 {
+	res, _ := c.ToMapStringFloat64E(value, converters...)
 	return res
 }
 
@@ -947,10 +1108,15 @@ func ToMapStringFloat64(value interface{}, converters ...MapStringFloat64Convert
 //	defaultValue := map[string]float64{"default": 0.0}
 //	convertedValue := ToMapStringFloat64OrDefault(someValue, defaultValue, customConverter)
 func ToMapStringFloat64OrDefault(value interface{}, defaultValue map[string]float64, converters ...MapStringFloat64Converter) map[string]float64 {
+	return std.ToMapStringFloat64OrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringFloat64OrDefault is like the package-level ToMapStringFloat64OrDefault but uses the options of c.
+func (c *Converter) ToMapStringFloat64OrDefault(value interface{}, defaultValue map[string]float64, converters ...MapStringFloat64Converter) map[string]float64 {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringFloat64E(value, converters...)
+	res, _ := c.ToMapStringFloat64E(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -985,11 +1151,19 @@ func ToMapStringFloat64OrDefault(value interface{}, defaultValue map[string]floa
 //		// Gérer l'erreur
 //	}
 func ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Converter) (map[string]float64, error) {
+	return std.ToMapStringFloat64E(value, converters...)
+}
+
+// ToMapStringFloat64E is like the package-level ToMapStringFloat64E but uses the options of c.
+func (c *Converter) ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Converter) (map[string]float64, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]float64]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -997,6 +1171,10 @@ func ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Conver
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]float64](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]float64:
 		return v, nil
@@ -1004,9 +1182,9 @@ func ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Conver
 	case map[string]interface{}:
 		res := make(map[string]float64)
 		for k, v := range v {
-			float64Value, err := ToFloat64E(v)
+			float64Value, err := c.ToFloat64E(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en float64 : %v", k, err)
+				return nil, pathError(err, keyPath(k), v, reflect.TypeFor[float64]())
 			}
 			res[k] = float64Value
 		}
@@ -1016,7 +1194,7 @@ func ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Conver
 		var res map[string]float64
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]float64](), syntaxError(err))
 		}
 		return res, nil
 
@@ -1024,15 +1202,264 @@ func ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Conver
 		var res map[string]float64
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]float64](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (float64, error) { return c.ToFloat64E(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]float64]())
 	}
 }
 
+// ToMapStringBigInt convertit n'importe quel type de valeur en map[string]*big.Int.
+// Si la conversion échoue, elle retourne une map vide.
+func ToMapStringBigInt(value interface{}, converters ...MapStringBigIntConverter) map[string]*big.Int {
+	return std.ToMapStringBigInt(value, converters...)
+}
+
+// ToMapStringBigInt is like the package-level ToMapStringBigInt but uses the options of c.
+func (c *Converter) ToMapStringBigInt(value interface{}, converters ...MapStringBigIntConverter) map[string]*big.Int {
+	res, _ := c.ToMapStringBigIntE(value, converters...)
+	return res
+}
+
+// ToMapStringBigIntOrDefault convertit n'importe quel type de valeur en map[string]*big.Int ou retourne une valeur par défaut.
+// Si la valeur d'entrée est nil ou si la conversion échoue, elle retourne la valeur par défaut.
+func ToMapStringBigIntOrDefault(value interface{}, defaultValue map[string]*big.Int, converters ...MapStringBigIntConverter) map[string]*big.Int {
+	return std.ToMapStringBigIntOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringBigIntOrDefault is like the package-level ToMapStringBigIntOrDefault but uses the options of c.
+func (c *Converter) ToMapStringBigIntOrDefault(value interface{}, defaultValue map[string]*big.Int, converters ...MapStringBigIntConverter) map[string]*big.Int {
+	if value == nil {
+		return defaultValue
+	}
+	res, _ := c.ToMapStringBigIntE(value, converters...)
+	if res == nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToMapStringBigIntE convertit n'importe quel type de valeur en map[string]*big.Int.
+// Chaque valeur est convertie avec ToBigIntE, sans perte de précision.
+//
+// Les types pris en charge sont :
+//   - map[string]*big.Int (retourné tel quel)
+//   - les maps et les structs (chaque valeur est convertie en *big.Int)
+//   - string et []byte (interprétés comme JSON, les nombres étant lus sans passer par float64)
+//
+// Pour les types non pris en charge, une erreur est retournée.
+func ToMapStringBigIntE(value interface{}, converters ...MapStringBigIntConverter) (map[string]*big.Int, error) {
+	return std.ToMapStringBigIntE(value, converters...)
+}
+
+// ToMapStringBigIntE is like the package-level ToMapStringBigIntE but uses the options of c.
+func (c *Converter) ToMapStringBigIntE(value interface{}, converters ...MapStringBigIntConverter) (map[string]*big.Int, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[map[string]*big.Int]())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[map[string]*big.Int](value); ok {
+		return res, err
+	}
+
+	switch v := i.(type) {
+	case map[string]*big.Int:
+		return v, nil
+
+	case string, []byte:
+		var m map[string]interface{}
+		if err := unmarshalNumbers(v, &m); err != nil {
+			return nil, newError(v, reflect.TypeFor[map[string]*big.Int](), syntaxError(err))
+		}
+		i = m
+	}
+
+	if res, ok, err := convertMap(c, i, func(val interface{}) (*big.Int, error) { return c.ToBigIntE(val) }); ok {
+		return res, err
+	}
+	return nil, unsupportedError(value, reflect.TypeFor[map[string]*big.Int]())
+}
+
+// ToMapStringBigFloat convertit n'importe quel type de valeur en map[string]*big.Float.
+// Si la conversion échoue, elle retourne une map vide.
+func ToMapStringBigFloat(value interface{}, converters ...MapStringBigFloatConverter) map[string]*big.Float {
+	return std.ToMapStringBigFloat(value, converters...)
+}
+
+// ToMapStringBigFloat is like the package-level ToMapStringBigFloat but uses the options of c.
+func (c *Converter) ToMapStringBigFloat(value interface{}, converters ...MapStringBigFloatConverter) map[string]*big.Float {
+	res, _ := c.ToMapStringBigFloatE(value, converters...)
+	return res
+}
+
+// ToMapStringBigFloatOrDefault convertit n'importe quel type de valeur en map[string]*big.Float ou retourne une valeur par défaut.
+// Si la valeur d'entrée est nil ou si la conversion échoue, elle retourne la valeur par défaut.
+func ToMapStringBigFloatOrDefault(value interface{}, defaultValue map[string]*big.Float, converters ...MapStringBigFloatConverter) map[string]*big.Float {
+	return std.ToMapStringBigFloatOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringBigFloatOrDefault is like the package-level ToMapStringBigFloatOrDefault but uses the options of c.
+func (c *Converter) ToMapStringBigFloatOrDefault(value interface{}, defaultValue map[string]*big.Float, converters ...MapStringBigFloatConverter) map[string]*big.Float {
+	if value == nil {
+		return defaultValue
+	}
+	res, _ := c.ToMapStringBigFloatE(value, converters...)
+	if res == nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToMapStringBigFloatE convertit n'importe quel type de valeur en map[string]*big.Float.
+// Chaque valeur est convertie avec ToBigFloatE, sans perte de précision.
+//
+// Les types pris en charge sont :
+//   - map[string]*big.Float (retourné tel quel)
+//   - les maps et les structs (chaque valeur est convertie en *big.Float)
+//   - string et []byte (interprétés comme JSON, les nombres étant lus sans passer par float64)
+//
+// Pour les types non pris en charge, une erreur est retournée.
+func ToMapStringBigFloatE(value interface{}, converters ...MapStringBigFloatConverter) (map[string]*big.Float, error) {
+	return std.ToMapStringBigFloatE(value, converters...)
+}
+
+// ToMapStringBigFloatE is like the package-level ToMapStringBigFloatE but uses the options of c.
+func (c *Converter) ToMapStringBigFloatE(value interface{}, converters ...MapStringBigFloatConverter) (map[string]*big.Float, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[map[string]*big.Float]())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[map[string]*big.Float](value); ok {
+		return res, err
+	}
+
+	switch v := i.(type) {
+	case map[string]*big.Float:
+		return v, nil
+
+	case string, []byte:
+		var m map[string]interface{}
+		if err := unmarshalNumbers(v, &m); err != nil {
+			return nil, newError(v, reflect.TypeFor[map[string]*big.Float](), syntaxError(err))
+		}
+		i = m
+	}
+
+	if res, ok, err := convertMap(c, i, func(val interface{}) (*big.Float, error) { return c.ToBigFloatE(val) }); ok {
+		return res, err
+	}
+	return nil, unsupportedError(value, reflect.TypeFor[map[string]*big.Float]())
+}
+
+// ToMapStringBigRat convertit n'importe quel type de valeur en map[string]*big.Rat.
+// Si la conversion échoue, elle retourne une map vide.
+func ToMapStringBigRat(value interface{}, converters ...MapStringBigRatConverter) map[string]*big.Rat {
+	return std.ToMapStringBigRat(value, converters...)
+}
+
+// ToMapStringBigRat is like the package-level ToMapStringBigRat but uses the options of c.
+func (c *Converter) ToMapStringBigRat(value interface{}, converters ...MapStringBigRatConverter) map[string]*big.Rat {
+	res, _ := c.ToMapStringBigRatE(value, converters...)
+	return res
+}
+
+// ToMapStringBigRatOrDefault convertit n'importe quel type de valeur en map[string]*big.Rat ou retourne une valeur par défaut.
+// Si la valeur d'entrée est nil ou si la conversion échoue, elle retourne la valeur par défaut.
+func ToMapStringBigRatOrDefault(value interface{}, defaultValue map[string]*big.Rat, converters ...MapStringBigRatConverter) map[string]*big.Rat {
+	return std.ToMapStringBigRatOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringBigRatOrDefault is like the package-level ToMapStringBigRatOrDefault but uses the options of c.
+func (c *Converter) ToMapStringBigRatOrDefault(value interface{}, defaultValue map[string]*big.Rat, converters ...MapStringBigRatConverter) map[string]*big.Rat {
+	if value == nil {
+		return defaultValue
+	}
+	res, _ := c.ToMapStringBigRatE(value, converters...)
+	if res == nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToMapStringBigRatE convertit n'importe quel type de valeur en map[string]*big.Rat.
+// Chaque valeur est convertie avec ToBigRatE, sans perte de précision.
+//
+// Les types pris en charge sont :
+//   - map[string]*big.Rat (retourné tel quel)
+//   - les maps et les structs (chaque valeur est convertie en *big.Rat)
+//   - string et []byte (interprétés comme JSON, les nombres étant lus sans passer par float64)
+//
+// Pour les types non pris en charge, une erreur est retournée.
+func ToMapStringBigRatE(value interface{}, converters ...MapStringBigRatConverter) (map[string]*big.Rat, error) {
+	return std.ToMapStringBigRatE(value, converters...)
+}
+
+// ToMapStringBigRatE is like the package-level ToMapStringBigRatE but uses the options of c.
+func (c *Converter) ToMapStringBigRatE(value interface{}, converters ...MapStringBigRatConverter) (map[string]*big.Rat, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[map[string]*big.Rat]())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[map[string]*big.Rat](value); ok {
+		return res, err
+	}
+
+	switch v := i.(type) {
+	case map[string]*big.Rat:
+		return v, nil
+
+	case string, []byte:
+		var m map[string]interface{}
+		if err := unmarshalNumbers(v, &m); err != nil {
+			return nil, newError(v, reflect.TypeFor[map[string]*big.Rat](), syntaxError(err))
+		}
+		i = m
+	}
+
+	if res, ok, err := convertMap(c, i, func(val interface{}) (*big.Rat, error) { return c.ToBigRatE(val) }); ok {
+		return res, err
+	}
+	return nil, unsupportedError(value, reflect.TypeFor[map[string]*big.Rat]())
+}
+
 // ToMapStringTime convertit n'importe quel type de valeur en map[string]time.Time.
 // Elle prend une valeur de n'importe quel type et un nombre variable de convertisseurs personnalisés.
 // Si la conversion échoue, elle retourne une map vide.
@@ -1049,7 +1476,12 @@ func ToMapStringFloat64E(value interface{}, converters ...MapStringFloat64Conver
 //
 //	convertedValue := ToMapStringTime(someValue, customConverter)
 func ToMapStringTime(value interface{}, converters ...MapStringTimeConverter) map[string]time.Time {
-	res, _ := ToMapStringTimeE(value, converters...)
+	return std.ToMapStringTime(value, converters...)
+}
+
+// ToMapStringTime is like the package-level ToMapStringTime but uses the options of c.
+func (c *Converter) ToMapStringTime(value interface{}, converters ...MapStringTimeConverter) map[string]time.Time {
+	res, _ := c.ToMapStringTimeE(value, converters...)
 	return res
 }
 
@@ -1070,10 +1502,15 @@ func ToMapStringTime(value interface{}, converters ...MapStringTimeConverter) ma
 //	defaultValue := map[string]time.Time{"default": time.Now()}
 //	convertedValue := ToMapStringTimeOrDefault(someValue, defaultValue, customConverter)
 func ToMapStringTimeOrDefault(value interface{}, defaultValue map[string]time.Time, converters ...MapStringTimeConverter) map[string]time.Time {
+	return std.ToMapStringTimeOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringTimeOrDefault is like the package-level ToMapStringTimeOrDefault but uses the options of c.
+func (c *Converter) ToMapStringTimeOrDefault(value interface{}, defaultValue map[string]time.Time, converters ...MapStringTimeConverter) map[string]time.Time {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringTimeE(value, converters...)
+	res, _ := c.ToMapStringTimeE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -1108,11 +1545,19 @@ func ToMapStringTimeOrDefault(value interface{}, defaultValue map[string]time.Ti
 //		// Gérer l'erreur
 //	}
 func ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (map[string]time.Time, error) {
+	return std.ToMapStringTimeE(value, converters...)
+}
+
+// ToMapStringTimeE is like the package-level ToMapStringTimeE but uses the options of c.
+func (c *Converter) ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (map[string]time.Time, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]time.Time]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -1120,6 +1565,10 @@ func ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]time.Time](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]time.Time:
 		return v, nil
@@ -1127,9 +1576,9 @@ func ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (
 	case map[string]interface{}:
 		res := make(map[string]time.Time)
 		for k, v := range v {
-			timeValue, err := ToTimeE(v)
+			timeValue, err := c.ToTimeE(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en time.Time : %v", k, err)
+				return nil, pathError(err, keyPath(k), v, reflect.TypeFor[time.Time]())
 			}
 			res[k] = timeValue
 		}
@@ -1139,7 +1588,7 @@ func ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (
 		var res map[string]time.Time
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]time.Time](), syntaxError(err))
 		}
 		return res, nil
 
@@ -1147,12 +1596,15 @@ func ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (
 		var res map[string]time.Time
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]time.Time](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (time.Time, error) { return c.ToTimeE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]time.Time]())
 	}
 }
 
@@ -1172,7 +1624,12 @@ func ToMapStringTimeE(value interface{}, converters ...MapStringTimeConverter) (
 //
 //	convertedValue := ToMapStringDuration(someValue, customConverter)
 func ToMapStringDuration(value interface{}, converters ...MapStringDurationConverter) map[string]time.Duration {
-	res, _ := ToMapStringDurationE(value, converters...)
+	return std.ToMapStringDuration(value, converters...)
+}
+
+// ToMapStringDuration is like the package-level ToMapStringDuration but uses the options of c.
+func (c *Converter) ToMapStringDuration(value interface{}, converters ...MapStringDurationConverter) map[string]time.Duration {
+	res, _ := c.ToMapStringDurationE(value, converters...)
 	return res
 }
 
@@ -1193,10 +1650,15 @@ func ToMapStringDuration(value interface{}, converters ...MapStringDurationConve
 //
 //	convertedValue := ToMapStringDurationOrDefault(someValue, defaultValue, customConverter)
 func ToMapStringDurationOrDefault(value interface{}, defaultValue map[string]time.Duration, converters ...MapStringDurationConverter) map[string]time.Duration {
+	return std.ToMapStringDurationOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringDurationOrDefault is like the package-level ToMapStringDurationOrDefault but uses the options of c.
+func (c *Converter) ToMapStringDurationOrDefault(value interface{}, defaultValue map[string]time.Duration, converters ...MapStringDurationConverter) map[string]time.Duration {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringDurationE(value, converters...)
+	res, _ := c.ToMapStringDurationE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -1207,11 +1669,19 @@ func ToMapStringDurationOrDefault(value interface{}, defaultValue map[string]tim
 // Elle prend n'importe quelle valeur et un nombre variable de convertisseurs personnalisés.
 // Elle retourne la map[string]time.Duration convertie et une erreur si la conversion échoue.
 func ToMapStringDurationE(value interface{}, converters ...MapStringDurationConverter) (map[string]time.Duration, error) {
+	return std.ToMapStringDurationE(value, converters...)
+}
+
+// ToMapStringDurationE is like the package-level ToMapStringDurationE but uses the options of c.
+func (c *Converter) ToMapStringDurationE(value interface{}, converters ...MapStringDurationConverter) (map[string]time.Duration, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]time.Duration]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -1219,6 +1689,10 @@ func ToMapStringDurationE(value interface{}, converters ...MapStringDurationConv
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]time.Duration](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]time.Duration:
 		return v, nil
@@ -1226,9 +1700,9 @@ func ToMapStringDurationE(value interface{}, converters ...MapStringDurationConv
 	case map[string]interface{}:
 		res := make(map[string]time.Duration)
 		for k, v := range v {
-			durationValue, err := ToDurationE(v)
+			durationValue, err := c.ToDurationE(v)
 			if err != nil {
-				return nil, fmt.Errorf("impossible de convertir la valeur pour la clé '%s' en time.Duration : %v", k, err)
+				return nil, pathError(err, keyPath(k), v, reflect.TypeFor[time.Duration]())
 			}
 			res[k] = durationValue
 		}
@@ -1238,7 +1712,7 @@ func ToMapStringDurationE(value interface{}, converters ...MapStringDurationConv
 		var res map[string]time.Duration
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]time.Duration](), syntaxError(err))
 		}
 		return res, nil
 
@@ -1246,12 +1720,139 @@ func ToMapStringDurationE(value interface{}, converters ...MapStringDurationConv
 		var res map[string]time.Duration
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]time.Duration](), syntaxError(err))
 		}
 		return res, nil
 
 	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+		if res, ok, err := convertMap(c, i, func(val interface{}) (time.Duration, error) { return c.ToDurationE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]time.Duration]())
+	}
+}
+
+// ToMapStringByteSize convertit une valeur en map[string]ByteSize.
+// Elle prend n'importe quelle valeur et un nombre variable de convertisseurs personnalisés.
+// Si la conversion échoue, elle retourne une map vide.
+//
+// Exemple d'utilisation :
+//
+//	customConverter := func(value interface{}) *map[string]ByteSize {
+//		if customVal, ok := value.(CustomType); ok {
+//			result := customVal.ToMapStringByteSize()
+//			return &result
+//		}
+//		return nil
+//	}
+//
+//	convertedValue := ToMapStringByteSize(someValue, customConverter)
+func ToMapStringByteSize(value interface{}, converters ...MapStringByteSizeConverter) map[string]ByteSize {
+	return std.ToMapStringByteSize(value, converters...)
+}
+
+// ToMapStringByteSize is like the package-level ToMapStringByteSize but uses the options of c.
+func (c *Converter) ToMapStringByteSize(value interface{}, converters ...MapStringByteSizeConverter) map[string]ByteSize {
+	res, _ := c.ToMapStringByteSizeE(value, converters...)
+	return res
+}
+
+// ToMapStringByteSizeOrDefault convertit une valeur en map[string]ByteSize avec une valeur par défaut.
+// Elle prend une valeur, une valeur par défaut, et un nombre variable de convertisseurs personnalisés.
+// Si la conversion échoue ou si la valeur d'entrée est nil, elle retourne la valeur par défaut.
+//
+// Exemple d'utilisation :
+//
+//	defaultValue := map[string]ByteSize{"key": 5 * MiB}
+//	customConverter := func(value interface{}) *map[string]ByteSize {
+//		if customVal, ok := value.(CustomType); ok {
+//			result := customVal.ToMapStringByteSize()
+//			return &result
+//		}
+//		return nil
+//	}
+//
+//	convertedValue := ToMapStringByteSizeOrDefault(someValue, defaultValue, customConverter)
+func ToMapStringByteSizeOrDefault(value interface{}, defaultValue map[string]ByteSize, converters ...MapStringByteSizeConverter) map[string]ByteSize {
+	return std.ToMapStringByteSizeOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringByteSizeOrDefault is like the package-level ToMapStringByteSizeOrDefault but uses the options of c.
+func (c *Converter) ToMapStringByteSizeOrDefault(value interface{}, defaultValue map[string]ByteSize, converters ...MapStringByteSizeConverter) map[string]ByteSize {
+	if value == nil {
+		return defaultValue
+	}
+	res, _ := c.ToMapStringByteSizeE(value, converters...)
+	if res == nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToMapStringByteSizeE convertit une valeur en map[string]ByteSize.
+// Elle prend n'importe quelle valeur et un nombre variable de convertisseurs personnalisés.
+// Elle retourne la map[string]ByteSize convertie et une erreur si la conversion échoue.
+func ToMapStringByteSizeE(value interface{}, converters ...MapStringByteSizeConverter) (map[string]ByteSize, error) {
+	return std.ToMapStringByteSizeE(value, converters...)
+}
+
+// ToMapStringByteSizeE is like the package-level ToMapStringByteSizeE but uses the options of c.
+func (c *Converter) ToMapStringByteSizeE(value interface{}, converters ...MapStringByteSizeConverter) (map[string]ByteSize, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[map[string]ByteSize]())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[map[string]ByteSize](value); ok {
+		return res, err
+	}
+
+	switch v := i.(type) {
+	case map[string]ByteSize:
+		return v, nil
+
+	case map[string]interface{}:
+		res := make(map[string]ByteSize)
+		for k, v := range v {
+			sizeValue, err := c.ToByteSizeE(v)
+			if err != nil {
+				return nil, pathError(err, keyPath(k), v, byteSizeType)
+			}
+			res[k] = sizeValue
+		}
+		return res, nil
+
+	case string:
+		var res map[string]ByteSize
+		err := json.Unmarshal([]byte(v), &res)
+		if err != nil {
+			return nil, newError(v, reflect.TypeFor[map[string]ByteSize](), syntaxError(err))
+		}
+		return res, nil
+
+	case []byte:
+		var res map[string]ByteSize
+		err := json.Unmarshal(v, &res)
+		if err != nil {
+			return nil, newError(v, reflect.TypeFor[map[string]ByteSize](), syntaxError(err))
+		}
+		return res, nil
+
+	default:
+		if res, ok, err := convertMap(c, i, func(val interface{}) (ByteSize, error) { return c.ToByteSizeE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]ByteSize]())
 	}
 }
 
@@ -1271,7 +1872,12 @@ func ToMapStringDurationE(value interface{}, converters ...MapStringDurationConv
 //
 //	valeurConvertie := ToMapStringInterface(uneValeur, convertisseurPersonnalise)
 func ToMapStringInterface(value interface{}, converters ...MapStringInterfaceConverter) map[string]interface{} {
-	res, _ := ToMapStringInterfaceE(value, converters...)
+	return std.ToMapStringInterface(value, converters...)
+}
+
+// ToMapStringInterface is like the package-level ToMapStringInterface but uses the options of c.
+func (c *Converter) ToMapStringInterface(value interface{}, converters ...MapStringInterfaceConverter) map[string]interface{} {
+	res, _ := c.ToMapStringInterfaceE(value, converters...)
 	return res
 }
 
@@ -1292,10 +1898,15 @@ func ToMapStringInterface(value interface{}, converters ...MapStringInterfaceCon
 //
 //	valeurConvertie := ToMapStringInterfaceOrDefault(uneValeur, valeurParDefaut, convertisseurPersonnalise)
 func ToMapStringInterfaceOrDefault(value interface{}, defaultValue map[string]interface{}, converters ...MapStringInterfaceConverter) map[string]interface{} {
+	return std.ToMapStringInterfaceOrDefault(value, defaultValue, converters...)
+}
+
+// ToMapStringInterfaceOrDefault is like the package-level ToMapStringInterfaceOrDefault but uses the options of c.
+func (c *Converter) ToMapStringInterfaceOrDefault(value interface{}, defaultValue map[string]interface{}, converters ...MapStringInterfaceConverter) map[string]interface{} {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToMapStringInterfaceE(value, converters...)
+	res, _ := c.ToMapStringInterfaceE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -1306,6 +1917,14 @@ func ToMapStringInterfaceOrDefault(value interface{}, defaultValue map[string]in
 // Elle prend n'importe quelle valeur et un nombre variable de convertisseurs personnalisés.
 // Elle retourne la map[string]interface{} convertie et une erreur si la conversion échoue.
 //
+// Les structs (et pointeurs vers struct) sont converties en map de leurs champs exportés :
+// les tags `convert:"nom"` ou `json:"nom"`, "-", "omitempty" et "inline" sont respectés,
+// et les champs des structs embarquées sont promus. Avec WithStructRecursion, les structs
+// imbriquées sont elles aussi converties en map.
+//
+// Les chaînes et []byte sont interprétés comme JSON ; WithJSONNumbers choisit comment
+// les nombres sont décodés (float64 par défaut, json.Number ou int64 quand ils sont entiers).
+//
 // Exemple d'utilisation :
 //
 //	convertisseurPersonnalise := func(valeur interface{}) *map[string]interface{} {
@@ -1321,11 +1940,19 @@ func ToMapStringInterfaceOrDefault(value interface{}, defaultValue map[string]in
 //		// Gérer l'erreur
 //	}
 func ToMapStringInterfaceE(value interface{}, converters ...MapStringInterfaceConverter) (map[string]interface{}, error) {
+	return std.ToMapStringInterfaceE(value, converters...)
+}
+
+// ToMapStringInterfaceE is like the package-level ToMapStringInterfaceE but uses the options of c.
+func (c *Converter) ToMapStringInterfaceE(value interface{}, converters ...MapStringInterfaceConverter) (map[string]interface{}, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[map[string]interface{}]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -1333,6 +1960,10 @@ func ToMapStringInterfaceE(value interface{}, converters ...MapStringInterfaceCo
 		}
 	}
 
+	if res, ok, err := fromRegistry[map[string]interface{}](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case map[string]interface{}:
 		return v, nil
@@ -1340,27 +1971,51 @@ func ToMapStringInterfaceE(value interface{}, converters ...MapStringInterfaceCo
 	case map[interface{}]interface{}:
 		res := make(map[string]interface{})
 		for k, v := range v {
-			res[ToString(k)] = v
+			key, _ := c.plainString(k)
+			res[key] = v
 		}
 		return res, nil
 
-	case string:
+	case string, []byte:
 		var res map[string]interface{}
-		err := json.Unmarshal([]byte(v), &res)
+		err := c.unmarshalJSON(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[map[string]interface{}](), syntaxError(err))
 		}
 		return res, nil
 
-	case []byte:
-		var res map[string]interface{}
-		err := json.Unmarshal(v, &res)
-		if err != nil {
-			return nil, err
+	default:
+		if res, ok, err := convertMap(c, i, func(val interface{}) (interface{}, error) { return val, nil }); ok {
+			return res, err
 		}
-		return res, nil
+		return nil, unsupportedError(value, reflect.TypeFor[map[string]interface{}]())
+	}
+}
 
-	default:
-		return nil, fmt.Errorf("type non pris en charge : %T", value)
+// convertMap converts every key of a map to string and every value with the given function.
+// Structs are first turned into maps of their fields with structToMap.
+// The boolean result reports whether value is a map or a struct.
+func convertMap[T any](c *Converter, value interface{}, convert func(interface{}) (T, error)) (map[string]T, bool, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Struct && isPlainStruct(v.Type()) {
+		v = reflect.ValueOf(c.structToMap(v))
+	}
+	if v.Kind() != reflect.Map {
+		return nil, false, nil
+	}
+
+	res := make(map[string]T, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := c.plainString(iter.Key().Interface())
+		if err != nil {
+			return nil, true, pathError(err, keyPath(fmt.Sprint(iter.Key().Interface())), iter.Key().Interface(), reflect.TypeFor[string]())
+		}
+		val, err := convert(iter.Value().Interface())
+		if err != nil {
+			return nil, true, pathError(err, keyPath(key), iter.Value().Interface(), reflect.TypeFor[T]())
+		}
+		res[key] = val
 	}
+	return res, true, nil
 }
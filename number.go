@@ -1,9 +1,11 @@
 package convert
 
 import (
-	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"strconv"
+	"time"
 )
 
 // IntConverter is a function type for custom int conversion.
@@ -414,189 +416,189 @@ type Float32ArrayConverter func(value interface{}) *[]float32
 //	convertedValue := ToFloat64Array(someValue, customFloat64ArrayConverter)
 type Float64ArrayConverter func(value interface{}) *[]float64
 
-// safeUint8 checks if a uint64 value can be converted to uint8 without overflow
-func safeUint8(value uint64) (uint8, error) {
-	if value > uint64(^uint8(0)) {
-		return 0, fmt.Errorf("convert: value %d overflows uint8", value)
+// safeUint8 checks if the uint64 n, read from value, can be converted to uint8 without overflow
+func safeUint8(value interface{}, n uint64) (uint8, error) {
+	if n > uint64(^uint8(0)) {
+		return 0, overflowError(value, reflect.TypeFor[uint8](), 1)
 	}
-	return uint8(value), nil
+	return uint8(n), nil
 }
 
-// safeUint16 checks if a uint64 value can be converted to uint16 without overflow
-func safeUint16(value uint64) (uint16, error) {
-	if value > uint64(^uint16(0)) {
-		return 0, fmt.Errorf("convert: value %d overflows uint16", value)
+// safeUint16 checks if the uint64 n, read from value, can be converted to uint16 without overflow
+func safeUint16(value interface{}, n uint64) (uint16, error) {
+	if n > uint64(^uint16(0)) {
+		return 0, overflowError(value, reflect.TypeFor[uint16](), 1)
 	}
-	return uint16(value), nil
+	return uint16(n), nil
 }
 
-// safeUint32 checks if a uint64 value can be converted to uint32 without overflow
-func safeUint32(value uint64) (uint32, error) {
-	if value > uint64(^uint32(0)) {
-		return 0, fmt.Errorf("convert: value %d overflows uint32", value)
+// safeUint32 checks if the uint64 n, read from value, can be converted to uint32 without overflow
+func safeUint32(value interface{}, n uint64) (uint32, error) {
+	if n > uint64(^uint32(0)) {
+		return 0, overflowError(value, reflect.TypeFor[uint32](), 1)
 	}
-	return uint32(value), nil
+	return uint32(n), nil
 }
 
-// safeInt8 checks if an int64 value can be converted to int8 without overflow
-func safeInt8(value int64) (int8, error) {
+// safeInt8 checks if the int64 n, read from value, can be converted to int8 without overflow
+func safeInt8(value interface{}, n int64) (int8, error) {
 	const minInt8 = int64(-128)
 	const maxInt8 = int64(127)
 
-	if value < minInt8 || value > maxInt8 {
-		return 0, fmt.Errorf("convert: value %d overflows int8", value)
+	if n < minInt8 || n > maxInt8 {
+		return 0, overflowError(value, reflect.TypeFor[int8](), signOf(n))
 	}
-	return int8(value), nil
+	return int8(n), nil
 }
 
-// safeInt16 checks if an int64 value can be converted to int16 without overflow
-func safeInt16(value int64) (int16, error) {
+// safeInt16 checks if the int64 n, read from value, can be converted to int16 without overflow
+func safeInt16(value interface{}, n int64) (int16, error) {
 	const minInt16 = int64(-32768)
 	const maxInt16 = int64(32767)
 
-	if value < minInt16 || value > maxInt16 {
-		return 0, fmt.Errorf("convert: value %d overflows int16", value)
+	if n < minInt16 || n > maxInt16 {
+		return 0, overflowError(value, reflect.TypeFor[int16](), signOf(n))
 	}
-	return int16(value), nil
+	return int16(n), nil
 }
 
-// safeInt32 checks if an int64 value can be converted to int32 without overflow
-func safeInt32(value int64) (int32, error) {
+// safeInt32 checks if the int64 n, read from value, can be converted to int32 without overflow
+func safeInt32(value interface{}, n int64) (int32, error) {
 	const minInt32 = int64(-2147483648)
 	const maxInt32 = int64(2147483647)
 
-	if value < minInt32 || value > maxInt32 {
-		return 0, fmt.Errorf("convert: value %d overflows int32", value)
+	if n < minInt32 || n > maxInt32 {
+		return 0, overflowError(value, reflect.TypeFor[int32](), signOf(n))
 	}
-	return int32(value), nil
+	return int32(n), nil
 }
 
-// safeInt checks if an int64 value can be converted to int without overflow
-func safeInt(value int64) (int, error) {
+// safeInt checks if the int64 n, read from value, can be converted to int without overflow
+func safeInt(value interface{}, n int64) (int, error) {
 	// On 32-bit systems, int is equivalent to int32
 	// On 64-bit systems, int is equivalent to int64
 	// We use a conservative approach for 32-bit systems
 	const minInt32 = int64(-2147483648)
 	const maxInt32 = int64(2147483647)
 
-	if strconv.IntSize == 32 && (value < minInt32 || value > maxInt32) {
-		return 0, fmt.Errorf("convert: value %d overflows int on 32-bit system", value)
+	if strconv.IntSize == 32 && (n < minInt32 || n > maxInt32) {
+		return 0, overflowError(value, reflect.TypeFor[int](), signOf(n))
 	}
-	return int(value), nil
+	return int(n), nil
 }
 
-// safeUint checks if a uint64 value can be converted to uint without overflow
-func safeUint(value uint64) (uint, error) {
+// safeUint checks if the uint64 n, read from value, can be converted to uint without overflow
+func safeUint(value interface{}, n uint64) (uint, error) {
 	// On 32-bit systems, uint is equivalent to uint32
 	// On 64-bit systems, uint is equivalent to uint64
 	// We use a conservative approach for 32-bit systems
 	const maxUint32 = uint64(^uint32(0))
 
-	if strconv.IntSize == 32 && value > maxUint32 {
-		return 0, fmt.Errorf("convert: value %d overflows uint on 32-bit system", value)
+	if strconv.IntSize == 32 && n > maxUint32 {
+		return 0, overflowError(value, reflect.TypeFor[uint](), 1)
 	}
-	return uint(value), nil
+	return uint(n), nil
 }
 
-// safeIntToUint8 checks if an int64 value can be converted to uint8 without overflow
-func safeIntToUint8(value int64) (uint8, error) {
-	if value < 0 || value > int64(255) {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to uint8", value)
+// safeIntToUint8 checks if the int64 n, read from value, can be converted to uint8 without overflow
+func safeIntToUint8(value interface{}, n int64) (uint8, error) {
+	if n < 0 || n > int64(255) {
+		return 0, overflowError(value, reflect.TypeFor[uint8](), signOf(n))
 	}
-	return uint8(value), nil
+	return uint8(n), nil
 }
 
-// safeIntToUint16 checks if an int64 value can be converted to uint16 without overflow
-func safeIntToUint16(value int64) (uint16, error) {
-	if value < 0 || value > int64(65535) {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to uint16", value)
+// safeIntToUint16 checks if the int64 n, read from value, can be converted to uint16 without overflow
+func safeIntToUint16(value interface{}, n int64) (uint16, error) {
+	if n < 0 || n > int64(65535) {
+		return 0, overflowError(value, reflect.TypeFor[uint16](), signOf(n))
 	}
-	return uint16(value), nil
+	return uint16(n), nil
 }
 
-// safeIntToUint32 checks if an int64 value can be converted to uint32 without overflow
-func safeIntToUint32(value int64) (uint32, error) {
-	if value < 0 || value > int64(4294967295) {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to uint32", value)
+// safeIntToUint32 checks if the int64 n, read from value, can be converted to uint32 without overflow
+func safeIntToUint32(value interface{}, n int64) (uint32, error) {
+	if n < 0 || n > int64(4294967295) {
+		return 0, overflowError(value, reflect.TypeFor[uint32](), signOf(n))
 	}
-	return uint32(value), nil
+	return uint32(n), nil
 }
 
-// safeIntToUint64 checks if an int64 value can be converted to uint64 without overflow
-func safeIntToUint64(value int64) (uint64, error) {
-	if value < 0 {
-		return 0, fmt.Errorf("convert: negative value %d cannot be converted to uint64", value)
+// safeIntToUint64 checks if the int64 n, read from value, can be converted to uint64 without overflow
+func safeIntToUint64(value interface{}, n int64) (uint64, error) {
+	if n < 0 {
+		return 0, overflowError(value, reflect.TypeFor[uint64](), signOf(n))
 	}
-	return uint64(value), nil
+	return uint64(n), nil
 }
 
-// safeIntToUint checks if an int64 value can be converted to uint without overflow
-func safeIntToUint(value int64) (uint, error) {
-	if value < 0 {
-		return 0, fmt.Errorf("convert: negative value %d cannot be converted to uint", value)
+// safeIntToUint checks if the int64 n, read from value, can be converted to uint without overflow
+func safeIntToUint(value interface{}, n int64) (uint, error) {
+	if n < 0 {
+		return 0, overflowError(value, reflect.TypeFor[uint](), signOf(n))
 	}
 
 	// On 32-bit systems, uint is equivalent to uint32
-	if strconv.IntSize == 32 && value > int64(^uint32(0)) {
-		return 0, fmt.Errorf("convert: value %d overflows uint on 32-bit system", value)
+	if strconv.IntSize == 32 && n > int64(^uint32(0)) {
+		return 0, overflowError(value, reflect.TypeFor[uint](), signOf(n))
 	}
 
-	return uint(value), nil
+	return uint(n), nil
 }
 
-// safeUintToInt8 checks if a uint64 value can be converted to int8 without overflow
-func safeUintToInt8(value uint64) (int8, error) {
+// safeUintToInt8 checks if the uint64 n, read from value, can be converted to int8 without overflow
+func safeUintToInt8(value interface{}, n uint64) (int8, error) {
 	const maxInt8 = uint64(127)
 
-	if value > maxInt8 {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to int8", value)
+	if n > maxInt8 {
+		return 0, overflowError(value, reflect.TypeFor[int8](), 1)
 	}
-	return int8(value), nil
+	return int8(n), nil
 }
 
-// safeUintToInt16 checks if a uint64 value can be converted to int16 without overflow
-func safeUintToInt16(value uint64) (int16, error) {
+// safeUintToInt16 checks if the uint64 n, read from value, can be converted to int16 without overflow
+func safeUintToInt16(value interface{}, n uint64) (int16, error) {
 	const maxInt16 = uint64(32767)
 
-	if value > maxInt16 {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to int16", value)
+	if n > maxInt16 {
+		return 0, overflowError(value, reflect.TypeFor[int16](), 1)
 	}
-	return int16(value), nil
+	return int16(n), nil
 }
 
-// safeUintToInt32 checks if a uint64 value can be converted to int32 without overflow
-func safeUintToInt32(value uint64) (int32, error) {
+// safeUintToInt32 checks if the uint64 n, read from value, can be converted to int32 without overflow
+func safeUintToInt32(value interface{}, n uint64) (int32, error) {
 	const maxInt32 = uint64(2147483647)
 
-	if value > maxInt32 {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to int32", value)
+	if n > maxInt32 {
+		return 0, overflowError(value, reflect.TypeFor[int32](), 1)
 	}
-	return int32(value), nil
+	return int32(n), nil
 }
 
-// safeUintToInt64 checks if a uint64 value can be converted to int64 without overflow
-func safeUintToInt64(value uint64) (int64, error) {
+// safeUintToInt64 checks if the uint64 n, read from value, can be converted to int64 without overflow
+func safeUintToInt64(value interface{}, n uint64) (int64, error) {
 	const maxInt64 = uint64(9223372036854775807)
 
-	if value > maxInt64 {
-		return 0, fmt.Errorf("convert: value %d cannot be converted to int64", value)
+	if n > maxInt64 {
+		return 0, overflowError(value, reflect.TypeFor[int64](), 1)
 	}
-	return int64(value), nil
+	return int64(n), nil
 }
 
-// safeUintToInt checks if a uint64 value can be converted to int without overflow
-func safeUintToInt(value uint64) (int, error) {
+// safeUintToInt checks if the uint64 n, read from value, can be converted to int without overflow
+func safeUintToInt(value interface{}, n uint64) (int, error) {
 	// On 32-bit systems, int is equivalent to int32
 	const maxInt32 = uint64(2147483647)
 	const maxInt64 = uint64(9223372036854775807)
 
-	if strconv.IntSize == 32 && value > maxInt32 {
-		return 0, fmt.Errorf("convert: value %d overflows int on 32-bit system", value)
-	} else if value > maxInt64 {
-		return 0, fmt.Errorf("convert: value %d overflows int64", value)
+	if strconv.IntSize == 32 && n > maxInt32 {
+		return 0, overflowError(value, reflect.TypeFor[int](), 1)
+	} else if n > maxInt64 {
+		return 0, overflowError(value, reflect.TypeFor[int](), 1)
 	}
 
-	return int(value), nil
+	return int(n), nil
 }
 
 // ToIntE converts any type of value to int or returns an error.
@@ -605,63 +607,104 @@ func safeUintToInt(value uint64) (int, error) {
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToIntE(value interface{}, converters ...IntConverter) (int, error) {
+	return std.ToIntE(value, converters...)
+}
+
+// ToIntE is like the package-level ToIntE but uses the options of c.
+func (c *Converter) ToIntE(value interface{}, converters ...IntConverter) (res int, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[int](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[int]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[int](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[int]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[int]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
-		}
-		if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeInt(res64)
-		} else if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUintToInt(resU64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			return int(resF64), nil
+			return 0, c.emptyString(reflect.TypeFor[int]())
+		}
+		if res64, err := c.parseInt(n); err == nil {
+			return safeInt(value, res64)
+		} else if resU64, err := c.parseUint(n); err == nil {
+			return safeUintToInt(value, resU64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[int]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[int](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToIntE(resBool)
+			return c.ToIntE(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to int failed", n)
+			return 0, newError(n, reflect.TypeFor[int](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[int](c, value, n)
 	case int, int8, int16, int32, int64:
 		return int(reflect.ValueOf(n).Int()), nil
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUintToInt(reflect.ValueOf(n).Uint())
+		return safeUintToInt(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return int(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[int](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToIntE(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToIntE(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[int]())
 	}
 }
 
 // ToInt converts any type of value to int, ignoring errors.
 func ToInt(value interface{}, converters ...IntConverter) int {
-	res, _ := ToIntE(value, converters...)
+	return std.ToInt(value, converters...)
+}
+
+// ToInt is like the package-level ToInt but uses the options of c.
+func (c *Converter) ToInt(value interface{}, converters ...IntConverter) int {
+	res, _ := c.ToIntE(value, converters...)
 	return res
 }
 
 // ToIntOrDefault converts any type of value to int or returns the provided default value if conversion fails.
 func ToIntOrDefault(value interface{}, defaultValue int, converters ...IntConverter) int {
+	return std.ToIntOrDefault(value, defaultValue, converters...)
+}
+
+// ToIntOrDefault is like the package-level ToIntOrDefault but uses the options of c.
+func (c *Converter) ToIntOrDefault(value interface{}, defaultValue int, converters ...IntConverter) int {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToIntE(value, converters...)
+	res, err := c.ToIntE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -674,66 +717,104 @@ func ToIntOrDefault(value interface{}, defaultValue int, converters ...IntConver
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt8E(value interface{}, converters ...Int8Converter) (int8, error) {
+	return std.ToInt8E(value, converters...)
+}
+
+// ToInt8E is like the package-level ToInt8E but uses the options of c.
+func (c *Converter) ToInt8E(value interface{}, converters ...Int8Converter) (res int8, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[int8](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[int8]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[int8](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[int8]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[int8]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
-		}
-		if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeInt8(res64)
-		} else if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUintToInt8(resU64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			if resF64 < 0 || resF64 > float64(^int8(0)) {
-				return 0, fmt.Errorf("convert: float value %f overflows int8", resF64)
-			}
-			return int8(resF64), nil
+			return 0, c.emptyString(reflect.TypeFor[int8]())
+		}
+		if res64, err := c.parseInt(n); err == nil {
+			return safeInt8(value, res64)
+		} else if resU64, err := c.parseUint(n); err == nil {
+			return safeUintToInt8(value, resU64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[int8]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[int8](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToInt8E(resBool)
+			return c.ToInt8E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to int8 failed", n)
+			return 0, newError(n, reflect.TypeFor[int8](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[int8](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeInt8(reflect.ValueOf(n).Int())
+		return safeInt8(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUintToInt8(reflect.ValueOf(n).Uint())
+		return safeUintToInt8(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return int8(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[int8](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToInt8E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToInt8E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[int8]())
 	}
 }
 
 // ToInt8 converts any type of value to int8, ignoring errors.
 func ToInt8(value interface{}, converters ...Int8Converter) int8 {
-	res, _ := ToInt8E(value, converters...)
+	return std.ToInt8(value, converters...)
+}
+
+// ToInt8 is like the package-level ToInt8 but uses the options of c.
+func (c *Converter) ToInt8(value interface{}, converters ...Int8Converter) int8 {
+	res, _ := c.ToInt8E(value, converters...)
 	return res
 }
 
 // ToInt8OrDefault converts any type of value to int8 or returns the provided default value if conversion fails.
 func ToInt8OrDefault(value interface{}, defaultValue int8, converters ...Int8Converter) int8 {
+	return std.ToInt8OrDefault(value, defaultValue, converters...)
+}
+
+// ToInt8OrDefault is like the package-level ToInt8OrDefault but uses the options of c.
+func (c *Converter) ToInt8OrDefault(value interface{}, defaultValue int8, converters ...Int8Converter) int8 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToInt8E(value, converters...)
+	res, err := c.ToInt8E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -746,69 +827,107 @@ func ToInt8OrDefault(value interface{}, defaultValue int8, converters ...Int8Con
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt16E(value interface{}, converters ...Int16Converter) (int16, error) {
+	return std.ToInt16E(value, converters...)
+}
+
+// ToInt16E is like the package-level ToInt16E but uses the options of c.
+func (c *Converter) ToInt16E(value interface{}, converters ...Int16Converter) (res int16, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[int16](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[int16]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[int16](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[int16]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[int16]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
+			return 0, c.emptyString(reflect.TypeFor[int16]())
 		}
-		if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeInt16(res64)
-		} else if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
+		if res64, err := c.parseInt(n); err == nil {
+			return safeInt16(value, res64)
+		} else if resU64, err := c.parseUint(n); err == nil {
 			if resU64 > uint64(32767) {
-				return 0, fmt.Errorf("convert: value %d overflows int16", resU64)
+				return 0, overflowError(value, reflect.TypeFor[int16](), 1)
 			}
 			return int16(resU64), nil
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			if resF64 < 0 || resF64 > float64(^int16(0)) {
-				return 0, fmt.Errorf("convert: float value %f overflows int16", resF64)
-			}
-			return int16(resF64), nil
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[int16]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[int16](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToInt16E(resBool)
+			return c.ToInt16E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to int16 failed", n)
+			return 0, newError(n, reflect.TypeFor[int16](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[int16](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeInt16(reflect.ValueOf(n).Int())
+		return safeInt16(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUintToInt16(reflect.ValueOf(n).Uint())
+		return safeUintToInt16(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return int16(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[int16](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToInt16E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToInt16E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[int16]())
 	}
 }
 
 // ToInt16 converts any type of value to int16, ignoring errors.
 func ToInt16(value interface{}, converters ...Int16Converter) int16 {
-	res, _ := ToInt16E(value, converters...)
+	return std.ToInt16(value, converters...)
+}
+
+// ToInt16 is like the package-level ToInt16 but uses the options of c.
+func (c *Converter) ToInt16(value interface{}, converters ...Int16Converter) int16 {
+	res, _ := c.ToInt16E(value, converters...)
 	return res
 }
 
 // ToInt16OrDefault converts any type of value to int16 or returns the provided default value if conversion fails.
 func ToInt16OrDefault(value interface{}, defaultValue int16, converters ...Int16Converter) int16 {
+	return std.ToInt16OrDefault(value, defaultValue, converters...)
+}
+
+// ToInt16OrDefault is like the package-level ToInt16OrDefault but uses the options of c.
+func (c *Converter) ToInt16OrDefault(value interface{}, defaultValue int16, converters ...Int16Converter) int16 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToInt16E(value, converters...)
+	res, err := c.ToInt16E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -821,66 +940,104 @@ func ToInt16OrDefault(value interface{}, defaultValue int16, converters ...Int16
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt32E(value interface{}, converters ...Int32Converter) (int32, error) {
+	return std.ToInt32E(value, converters...)
+}
+
+// ToInt32E is like the package-level ToInt32E but uses the options of c.
+func (c *Converter) ToInt32E(value interface{}, converters ...Int32Converter) (res int32, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[int32](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[int32]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[int32](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[int32]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[int32]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
-		}
-		if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeInt32(res64)
-		} else if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUintToInt32(resU64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			if resF64 < 0 || resF64 > float64(^int32(0)) {
-				return 0, fmt.Errorf("convert: float value %f overflows int32", resF64)
-			}
-			return int32(resF64), nil
+			return 0, c.emptyString(reflect.TypeFor[int32]())
+		}
+		if res64, err := c.parseInt(n); err == nil {
+			return safeInt32(value, res64)
+		} else if resU64, err := c.parseUint(n); err == nil {
+			return safeUintToInt32(value, resU64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[int32]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[int32](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToInt32E(resBool)
+			return c.ToInt32E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to int32 failed", n)
+			return 0, newError(n, reflect.TypeFor[int32](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[int32](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeInt32(reflect.ValueOf(n).Int())
+		return safeInt32(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUintToInt32(reflect.ValueOf(n).Uint())
+		return safeUintToInt32(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return int32(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[int32](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToInt32E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToInt32E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[int32]())
 	}
 }
 
 // ToInt32 converts any type of value to int32, ignoring errors.
 func ToInt32(value interface{}, converters ...Int32Converter) int32 {
-	res, _ := ToInt32E(value, converters...)
+	return std.ToInt32(value, converters...)
+}
+
+// ToInt32 is like the package-level ToInt32 but uses the options of c.
+func (c *Converter) ToInt32(value interface{}, converters ...Int32Converter) int32 {
+	res, _ := c.ToInt32E(value, converters...)
 	return res
 }
 
 // ToInt32OrDefault converts any type of value to int32 or returns the provided default value if conversion fails.
 func ToInt32OrDefault(value interface{}, defaultValue int32, converters ...Int32Converter) int32 {
+	return std.ToInt32OrDefault(value, defaultValue, converters...)
+}
+
+// ToInt32OrDefault is like the package-level ToInt32OrDefault but uses the options of c.
+func (c *Converter) ToInt32OrDefault(value interface{}, defaultValue int32, converters ...Int32Converter) int32 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToInt32E(value, converters...)
+	res, err := c.ToInt32E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -893,63 +1050,105 @@ func ToInt32OrDefault(value interface{}, defaultValue int32, converters ...Int32
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
+//   - time.Time, as a Unix timestamp, with WithUnixTime
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt64E(value interface{}, converters ...Int64Converter) (int64, error) {
+	return std.ToInt64E(value, converters...)
+}
+
+// ToInt64E is like the package-level ToInt64E but uses the options of c.
+func (c *Converter) ToInt64E(value interface{}, converters ...Int64Converter) (res int64, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[int64](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[int64]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[int64](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[int64]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[int64]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
+			return 0, c.emptyString(reflect.TypeFor[int64]())
 		}
-		if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
+		if res64, err := c.parseInt(n); err == nil {
 			return res64, nil
-		} else if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUintToInt64(resU64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			return int64(resF64), nil
+		} else if resU64, err := c.parseUint(n); err == nil {
+			return safeUintToInt64(value, resU64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[int64]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[int64](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToInt64E(resBool)
+			return c.ToInt64E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to int64 failed", n)
+			return 0, newError(n, reflect.TypeFor[int64](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[int64](c, value, n)
 	case int, int8, int16, int32, int64:
 		return reflect.ValueOf(n).Int(), nil
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUintToInt64(reflect.ValueOf(n).Uint())
+		return safeUintToInt64(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return int64(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[int64](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToInt64E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToInt64E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[int64]())
 	}
 }
 
 // ToInt64 converts any type of value to int64, ignoring errors.
 func ToInt64(value interface{}, converters ...Int64Converter) int64 {
-	res, _ := ToInt64E(value, converters...)
+	return std.ToInt64(value, converters...)
+}
+
+// ToInt64 is like the package-level ToInt64 but uses the options of c.
+func (c *Converter) ToInt64(value interface{}, converters ...Int64Converter) int64 {
+	res, _ := c.ToInt64E(value, converters...)
 	return res
 }
 
 // ToInt64OrDefault converts any type of value to int64 or returns the provided default value if conversion fails.
 func ToInt64OrDefault(value interface{}, defaultValue int64, converters ...Int64Converter) int64 {
+	return std.ToInt64OrDefault(value, defaultValue, converters...)
+}
+
+// ToInt64OrDefault is like the package-level ToInt64OrDefault but uses the options of c.
+func (c *Converter) ToInt64OrDefault(value interface{}, defaultValue int64, converters ...Int64Converter) int64 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToInt64E(value, converters...)
+	res, err := c.ToInt64E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -962,63 +1161,104 @@ func ToInt64OrDefault(value interface{}, defaultValue int64, converters ...Int64
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUintE(value interface{}, converters ...UintConverter) (uint, error) {
+	return std.ToUintE(value, converters...)
+}
+
+// ToUintE is like the package-level ToUintE but uses the options of c.
+func (c *Converter) ToUintE(value interface{}, converters ...UintConverter) (res uint, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[uint](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[uint]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[uint](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[uint]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[uint]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
-		}
-		if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUint(resU64)
-		} else if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeIntToUint(res64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			return uint(resF64), nil
+			return 0, c.emptyString(reflect.TypeFor[uint]())
+		}
+		if resU64, err := c.parseUint(n); err == nil {
+			return safeUint(value, resU64)
+		} else if res64, err := c.parseInt(n); err == nil {
+			return safeIntToUint(value, res64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[uint]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[uint](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToUintE(resBool)
+			return c.ToUintE(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to uint failed", n)
+			return 0, newError(n, reflect.TypeFor[uint](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[uint](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeIntToUint(reflect.ValueOf(n).Int())
+		return safeIntToUint(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUint(reflect.ValueOf(n).Uint())
+		return safeUint(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return uint(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[uint](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToUintE(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToUintE(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[uint]())
 	}
 }
 
 // ToUint converts any type of value to uint, ignoring errors.
 func ToUint(value interface{}, converters ...UintConverter) uint {
-	res, _ := ToUintE(value, converters...)
+	return std.ToUint(value, converters...)
+}
+
+// ToUint is like the package-level ToUint but uses the options of c.
+func (c *Converter) ToUint(value interface{}, converters ...UintConverter) uint {
+	res, _ := c.ToUintE(value, converters...)
 	return res
 }
 
 // ToUintOrDefault converts any type of value to uint or returns the provided default value if conversion fails.
 func ToUintOrDefault(value interface{}, defaultValue uint, converters ...UintConverter) uint {
+	return std.ToUintOrDefault(value, defaultValue, converters...)
+}
+
+// ToUintOrDefault is like the package-level ToUintOrDefault but uses the options of c.
+func (c *Converter) ToUintOrDefault(value interface{}, defaultValue uint, converters ...UintConverter) uint {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToUintE(value, converters...)
+	res, err := c.ToUintE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1031,66 +1271,104 @@ func ToUintOrDefault(value interface{}, defaultValue uint, converters ...UintCon
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint8E(value interface{}, converters ...Uint8Converter) (uint8, error) {
+	return std.ToUint8E(value, converters...)
+}
+
+// ToUint8E is like the package-level ToUint8E but uses the options of c.
+func (c *Converter) ToUint8E(value interface{}, converters ...Uint8Converter) (res uint8, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[uint8](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[uint8]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[uint8](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[uint8]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[uint8]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
-		}
-		if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUint8(resU64)
-		} else if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeIntToUint8(res64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			if resF64 < 0 || resF64 > float64(^uint8(0)) {
-				return 0, fmt.Errorf("convert: float value %f overflows uint8", resF64)
-			}
-			return uint8(resF64), nil
+			return 0, c.emptyString(reflect.TypeFor[uint8]())
+		}
+		if resU64, err := c.parseUint(n); err == nil {
+			return safeUint8(value, resU64)
+		} else if res64, err := c.parseInt(n); err == nil {
+			return safeIntToUint8(value, res64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[uint8]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[uint8](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToUint8E(resBool)
+			return c.ToUint8E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to uint8 failed", n)
+			return 0, newError(n, reflect.TypeFor[uint8](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[uint8](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeIntToUint8(reflect.ValueOf(n).Int())
+		return safeIntToUint8(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUint8(reflect.ValueOf(n).Uint())
+		return safeUint8(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return uint8(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[uint8](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToUint8E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToUint8E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[uint8]())
 	}
 }
 
 // ToUint8 converts any type of value to uint8, ignoring errors.
 func ToUint8(value interface{}, converters ...Uint8Converter) uint8 {
-	res, _ := ToUint8E(value, converters...)
+	return std.ToUint8(value, converters...)
+}
+
+// ToUint8 is like the package-level ToUint8 but uses the options of c.
+func (c *Converter) ToUint8(value interface{}, converters ...Uint8Converter) uint8 {
+	res, _ := c.ToUint8E(value, converters...)
 	return res
 }
 
 // ToUint8OrDefault converts any type of value to uint8 or returns the provided default value if conversion fails.
 func ToUint8OrDefault(value interface{}, defaultValue uint8, converters ...Uint8Converter) uint8 {
+	return std.ToUint8OrDefault(value, defaultValue, converters...)
+}
+
+// ToUint8OrDefault is like the package-level ToUint8OrDefault but uses the options of c.
+func (c *Converter) ToUint8OrDefault(value interface{}, defaultValue uint8, converters ...Uint8Converter) uint8 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToUint8E(value, converters...)
+	res, err := c.ToUint8E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1103,72 +1381,110 @@ func ToUint8OrDefault(value interface{}, defaultValue uint8, converters ...Uint8
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint16E(value interface{}, converters ...Uint16Converter) (uint16, error) {
+	return std.ToUint16E(value, converters...)
+}
+
+// ToUint16E is like the package-level ToUint16E but uses the options of c.
+func (c *Converter) ToUint16E(value interface{}, converters ...Uint16Converter) (res uint16, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[uint16](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[uint16]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[uint16](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[uint16]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[uint16]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
+			return 0, c.emptyString(reflect.TypeFor[uint16]())
 		}
-		if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUint16(resU64)
-		} else if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
+		if resU64, err := c.parseUint(n); err == nil {
+			return safeUint16(value, resU64)
+		} else if res64, err := c.parseInt(n); err == nil {
 			if res64 < 0 {
-				return 0, fmt.Errorf("convert: negative value %d cannot be converted to uint16", res64)
+				return 0, overflowError(value, reflect.TypeFor[uint16](), -1)
 			}
 			if res64 > int64(65535) {
-				return 0, fmt.Errorf("convert: value %d overflows uint16", res64)
+				return 0, overflowError(value, reflect.TypeFor[uint16](), 1)
 			}
 			return uint16(res64), nil
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			if resF64 < 0 || resF64 > float64(^uint16(0)) {
-				return 0, fmt.Errorf("convert: float value %f overflows uint16", resF64)
-			}
-			return uint16(resF64), nil
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[uint16]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[uint16](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToUint16E(resBool)
+			return c.ToUint16E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to uint16 failed", n)
+			return 0, newError(n, reflect.TypeFor[uint16](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[uint16](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeIntToUint16(reflect.ValueOf(n).Int())
+		return safeIntToUint16(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUint16(reflect.ValueOf(n).Uint())
+		return safeUint16(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return uint16(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[uint16](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToUint16E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToUint16E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[uint16]())
 	}
 }
 
 // ToUint16 converts any type of value to uint16, ignoring errors.
 func ToUint16(value interface{}, converters ...Uint16Converter) uint16 {
-	res, _ := ToUint16E(value, converters...)
+	return std.ToUint16(value, converters...)
+}
+
+// ToUint16 is like the package-level ToUint16 but uses the options of c.
+func (c *Converter) ToUint16(value interface{}, converters ...Uint16Converter) uint16 {
+	res, _ := c.ToUint16E(value, converters...)
 	return res
 }
 
 // ToUint16OrDefault converts any type of value to uint16 or returns the provided default value if conversion fails.
 func ToUint16OrDefault(value interface{}, defaultValue uint16, converters ...Uint16Converter) uint16 {
+	return std.ToUint16OrDefault(value, defaultValue, converters...)
+}
+
+// ToUint16OrDefault is like the package-level ToUint16OrDefault but uses the options of c.
+func (c *Converter) ToUint16OrDefault(value interface{}, defaultValue uint16, converters ...Uint16Converter) uint16 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToUint16E(value, converters...)
+	res, err := c.ToUint16E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1181,66 +1497,104 @@ func ToUint16OrDefault(value interface{}, defaultValue uint16, converters ...Uin
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint32E(value interface{}, converters ...Uint32Converter) (uint32, error) {
+	return std.ToUint32E(value, converters...)
+}
+
+// ToUint32E is like the package-level ToUint32E but uses the options of c.
+func (c *Converter) ToUint32E(value interface{}, converters ...Uint32Converter) (res uint32, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[uint32](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[uint32]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[uint32](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[uint32]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[uint32]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
-		}
-		if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
-			return safeUint32(resU64)
-		} else if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeIntToUint32(res64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			if resF64 < 0 || resF64 > float64(^uint32(0)) {
-				return 0, fmt.Errorf("convert: float value %f overflows uint32", resF64)
-			}
-			return uint32(resF64), nil
+			return 0, c.emptyString(reflect.TypeFor[uint32]())
+		}
+		if resU64, err := c.parseUint(n); err == nil {
+			return safeUint32(value, resU64)
+		} else if res64, err := c.parseInt(n); err == nil {
+			return safeIntToUint32(value, res64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[uint32]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[uint32](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToUint32E(resBool)
+			return c.ToUint32E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to uint32 failed", n)
+			return 0, newError(n, reflect.TypeFor[uint32](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[uint32](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeIntToUint32(reflect.ValueOf(n).Int())
+		return safeIntToUint32(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
-		return safeUint32(reflect.ValueOf(n).Uint())
+		return safeUint32(value, reflect.ValueOf(n).Uint())
 	case float32, float64:
-		return uint32(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[uint32](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToUint32E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToUint32E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[uint32]())
 	}
 }
 
 // ToUint32 converts any type of value to uint32, ignoring errors.
 func ToUint32(value interface{}, converters ...Uint32Converter) uint32 {
-	res, _ := ToUint32E(value, converters...)
+	return std.ToUint32(value, converters...)
+}
+
+// ToUint32 is like the package-level ToUint32 but uses the options of c.
+func (c *Converter) ToUint32(value interface{}, converters ...Uint32Converter) uint32 {
+	res, _ := c.ToUint32E(value, converters...)
 	return res
 }
 
 // ToUint32OrDefault converts any type of value to uint32 or returns the provided default value if conversion fails.
 func ToUint32OrDefault(value interface{}, defaultValue uint32, converters ...Uint32Converter) uint32 {
+	return std.ToUint32OrDefault(value, defaultValue, converters...)
+}
+
+// ToUint32OrDefault is like the package-level ToUint32OrDefault but uses the options of c.
+func (c *Converter) ToUint32OrDefault(value interface{}, defaultValue uint32, converters ...Uint32Converter) uint32 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToUint32E(value, converters...)
+	res, err := c.ToUint32E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1253,63 +1607,104 @@ func ToUint32OrDefault(value interface{}, defaultValue uint32, converters ...Uin
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - *big.Int, *big.Float and *big.Rat, with the same rounding and range checks
+//   - json.Number, parsed exactly rather than through float64
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint64E(value interface{}, converters ...Uint64Converter) (uint64, error) {
+	return std.ToUint64E(value, converters...)
+}
+
+// ToUint64E is like the package-level ToUint64E but uses the options of c.
+func (c *Converter) ToUint64E(value interface{}, converters ...Uint64Converter) (res uint64, err error) {
+	defer saturate(c, &res, &err)
+
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[uint64](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[uint64]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixInteger[uint64](c, value, t, c.opts.unixUnit)
+	}
+	i = basicValue(numberValue(i))
+	if i, err = c.localNumber(i, reflect.TypeFor[uint64]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictInteger(i, reflect.TypeFor[uint64]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
+			return 0, c.emptyString(reflect.TypeFor[uint64]())
 		}
-		if resU64, err := strconv.ParseUint(n, 0, 64); err == nil {
+		if resU64, err := c.parseUint(n); err == nil {
 			return resU64, nil
-		} else if res64, err := strconv.ParseInt(n, 0, 64); err == nil {
-			return safeIntToUint64(res64)
-		} else if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			return uint64(resF64), nil
+		} else if res64, err := c.parseInt(n); err == nil {
+			return safeIntToUint64(value, res64)
+		} else if c.opts.integerBase != 0 {
+			return 0, c.radixError(value, n, reflect.TypeFor[uint64]())
+		} else if resF64, ok := parseFloat(n); ok {
+			return floatToInteger[uint64](c, value, resF64)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToUint64E(resBool)
+			return c.ToUint64E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to uint64 failed", n)
+			return 0, newError(n, reflect.TypeFor[uint64](), ErrSyntax)
 		}
+	case big.Int, big.Float, big.Rat:
+		return bigToInteger[uint64](c, value, n)
 	case int, int8, int16, int32, int64:
-		return safeIntToUint64(reflect.ValueOf(n).Int())
+		return safeIntToUint64(value, reflect.ValueOf(n).Int())
 	case uint, uint8, uint16, uint32, uint64, uintptr:
 		return uint64(reflect.ValueOf(n).Uint()), nil
 	case float32, float64:
-		return uint64(reflect.ValueOf(n).Float()), nil
+		return floatToInteger[uint64](c, n, reflect.ValueOf(n).Float())
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToUint64E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToUint64E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[uint64]())
 	}
 }
 
 // ToUint64 converts any type of value to uint64, ignoring errors.
 func ToUint64(value interface{}, converters ...Uint64Converter) uint64 {
-	res, _ := ToUint64E(value, converters...)
+	return std.ToUint64(value, converters...)
+}
+
+// ToUint64 is like the package-level ToUint64 but uses the options of c.
+func (c *Converter) ToUint64(value interface{}, converters ...Uint64Converter) uint64 {
+	res, _ := c.ToUint64E(value, converters...)
 	return res
 }
 
 // ToUint64OrDefault converts any type of value to uint64 or returns the provided default value if conversion fails.
 func ToUint64OrDefault(value interface{}, defaultValue uint64, converters ...Uint64Converter) uint64 {
+	return std.ToUint64OrDefault(value, defaultValue, converters...)
+}
+
+// ToUint64OrDefault is like the package-level ToUint64OrDefault but uses the options of c.
+func (c *Converter) ToUint64OrDefault(value interface{}, defaultValue uint64, converters ...Uint64Converter) uint64 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToUint64E(value, converters...)
+	res, err := c.ToUint64E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1326,25 +1721,50 @@ func ToUint64OrDefault(value interface{}, defaultValue uint64, converters ...Uin
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToFloat32E(value interface{}, converters ...Float32Converter) (float32, error) {
+	return std.ToFloat32E(value, converters...)
+}
+
+// ToFloat32E is like the package-level ToFloat32E but uses the options of c.
+func (c *Converter) ToFloat32E(value interface{}, converters ...Float32Converter) (float32, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[float32](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[float32]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixFloat[float32](t, c.opts.unixUnit), nil
+	}
+	i = basicValue(i)
+	if i, err = c.localNumber(i, reflect.TypeFor[float32]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictFloat(i, reflect.TypeFor[float32]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
+			return 0, c.emptyString(reflect.TypeFor[float32]())
 		}
-		if resF64, err := strconv.ParseFloat(n, 64); err == nil {
-			return float32(resF64), nil
+		if resF64, ok := parseFloat(n); ok {
+			if math.IsInf(resF64, 0) && !isInfString(n) {
+				return 0, newError(value, reflect.TypeFor[float32](), ErrOverflow)
+			}
+			return c.narrowFloat32(value, resF64, false)
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToFloat32E(resBool)
+			return c.ToFloat32E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to float32 failed", n)
+			return 0, newError(n, reflect.TypeFor[float32](), ErrSyntax)
 		}
 	case int, int8, int16, int32, int64:
 		return float32(reflect.ValueOf(n).Int()), nil
@@ -1353,30 +1773,41 @@ func ToFloat32E(value interface{}, converters ...Float32Converter) (float32, err
 	case float32:
 		return n, nil
 	case float64:
-		return float32(n), nil
+		return c.narrowFloat32(value, n, true)
 	case bool:
 		if n {
 			return 1, nil
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToFloat32E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToFloat32E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[float32]())
 	}
 }
 
 // ToFloat32 converts any type of value to float32, ignoring errors.
 func ToFloat32(value interface{}, converters ...Float32Converter) float32 {
-	res, _ := ToFloat32E(value, converters...)
+	return std.ToFloat32(value, converters...)
+}
+
+// ToFloat32 is like the package-level ToFloat32 but uses the options of c.
+func (c *Converter) ToFloat32(value interface{}, converters ...Float32Converter) float32 {
+	res, _ := c.ToFloat32E(value, converters...)
 	return res
 }
 
 // ToFloat32OrDefault converts any type of value to float32 or returns the provided default value if conversion fails.
 func ToFloat32OrDefault(value interface{}, defaultValue float32, converters ...Float32Converter) float32 {
+	return std.ToFloat32OrDefault(value, defaultValue, converters...)
+}
+
+// ToFloat32OrDefault is like the package-level ToFloat32OrDefault but uses the options of c.
+func (c *Converter) ToFloat32OrDefault(value interface{}, defaultValue float32, converters ...Float32Converter) float32 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToFloat32E(value, converters...)
+	res, err := c.ToFloat32E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1389,29 +1820,55 @@ func ToFloat32OrDefault(value interface{}, defaultValue float32, converters ...F
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - time.Time, as a Unix timestamp with its fraction, with WithUnixTime
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToFloat64E(value interface{}, converters ...Float64Converter) (float64, error) {
+	return std.ToFloat64E(value, converters...)
+}
+
+// ToFloat64E is like the package-level ToFloat64E but uses the options of c.
+func (c *Converter) ToFloat64E(value interface{}, converters ...Float64Converter) (float64, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[float64](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[float64]())
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := i.(time.Time); ok && c.opts.unixTime {
+		return unixFloat[float64](t, c.opts.unixUnit), nil
+	}
+	i = basicValue(i)
+	if i, err = c.localNumber(i, reflect.TypeFor[float64]()); err != nil {
+		return 0, err
+	}
+	if err := c.strictFloat(i, reflect.TypeFor[float64]()); err != nil {
+		return 0, err
+	}
 
 	switch n := i.(type) {
 	case string:
 		if len(n) == 0 {
-			return 0, nil
+			return 0, c.emptyString(reflect.TypeFor[float64]())
 		}
-		if resF64, err := strconv.ParseFloat(n, 64); err == nil {
+		if resF64, ok := parseFloat(n); ok {
+			if math.IsInf(resF64, 0) && !isInfString(n) {
+				return 0, newError(value, reflect.TypeFor[float64](), ErrOverflow)
+			}
 			return resF64, nil
 		} else if resBool, err := strconv.ParseBool(n); err == nil {
-			return ToFloat64E(resBool)
+			return c.ToFloat64E(resBool)
 		} else {
-			return 0, fmt.Errorf("convert: string \"%s\" to float64 failed", n)
+			return 0, newError(n, reflect.TypeFor[float64](), ErrSyntax)
 		}
 	case int, int8, int16, int32, int64:
 		return float64(reflect.ValueOf(n).Int()), nil
@@ -1427,23 +1884,34 @@ func ToFloat64E(value interface{}, converters ...Float64Converter) (float64, err
 		}
 		return 0, nil
 	default:
-		valueStr := ToString(n)
-		return ToFloat64E(valueStr)
+		valueStr, _ := c.plainString(n)
+		res, err := c.ToFloat64E(valueStr)
+		return res, stringFormError(err, value, reflect.TypeFor[float64]())
 	}
 }
 
 // ToFloat64 converts any type of value to float64, ignoring errors.
 func ToFloat64(value interface{}, converters ...Float64Converter) float64 {
-	res, _ := ToFloat64E(value, converters...)
+	return std.ToFloat64(value, converters...)
+}
+
+// ToFloat64 is like the package-level ToFloat64 but uses the options of c.
+func (c *Converter) ToFloat64(value interface{}, converters ...Float64Converter) float64 {
+	res, _ := c.ToFloat64E(value, converters...)
 	return res
 }
 
 // ToFloat64OrDefault converts any type of value to float64 or returns the provided default value if conversion fails.
 func ToFloat64OrDefault(value interface{}, defaultValue float64, converters ...Float64Converter) float64 {
+	return std.ToFloat64OrDefault(value, defaultValue, converters...)
+}
+
+// ToFloat64OrDefault is like the package-level ToFloat64OrDefault but uses the options of c.
+func (c *Converter) ToFloat64OrDefault(value interface{}, defaultValue float64, converters ...Float64Converter) float64 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToFloat64E(value, converters...)
+	res, err := c.ToFloat64E(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1460,38 +1928,57 @@ func ToFloat64OrDefault(value interface{}, defaultValue float64, converters ...F
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToIntArrayE(value interface{}, converters ...IntArrayConverter) ([]int, error) {
+	return std.ToIntArrayE(value, converters...)
+}
+
+// ToIntArrayE is like the package-level ToIntArrayE but uses the options of c.
+func (c *Converter) ToIntArrayE(value interface{}, converters ...IntArrayConverter) ([]int, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]int](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]int, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToIntE(v.Index(i).Interface())
+			res, err := c.ToIntE(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]int]())
 	}
 }
 
 // ToIntArray converts any type of value to an array of int, ignoring errors.
 func ToIntArray(value interface{}, converters ...IntArrayConverter) []int {
-	res, _ := ToIntArrayE(value, converters...)
+	return std.ToIntArray(value, converters...)
+}
+
+// ToIntArray is like the package-level ToIntArray but uses the options of c.
+func (c *Converter) ToIntArray(value interface{}, converters ...IntArrayConverter) []int {
+	res, _ := c.ToIntArrayE(value, converters...)
 	return res
 }
 
 // ToIntArrayOrDefault converts any type of value to an array of int or returns the provided default array if conversion fails.
 func ToIntArrayOrDefault(value interface{}, defaultValue []int, converters ...IntArrayConverter) []int {
-	res, err := ToIntArrayE(value, converters...)
+	return std.ToIntArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToIntArrayOrDefault is like the package-level ToIntArrayOrDefault but uses the options of c.
+func (c *Converter) ToIntArrayOrDefault(value interface{}, defaultValue []int, converters ...IntArrayConverter) []int {
+	res, err := c.ToIntArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1508,38 +1995,57 @@ func ToIntArrayOrDefault(value interface{}, defaultValue []int, converters ...In
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt8ArrayE(value interface{}, converters ...Int8ArrayConverter) ([]int8, error) {
+	return std.ToInt8ArrayE(value, converters...)
+}
+
+// ToInt8ArrayE is like the package-level ToInt8ArrayE but uses the options of c.
+func (c *Converter) ToInt8ArrayE(value interface{}, converters ...Int8ArrayConverter) ([]int8, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]int8](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]int8, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToInt8E(v.Index(i).Interface())
+			res, err := c.ToInt8E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]int8]())
 	}
 }
 
 // ToInt8Array converts any type of value to an array of int8, ignoring errors.
 func ToInt8Array(value interface{}, converters ...Int8ArrayConverter) []int8 {
-	res, _ := ToInt8ArrayE(value, converters...)
+	return std.ToInt8Array(value, converters...)
+}
+
+// ToInt8Array is like the package-level ToInt8Array but uses the options of c.
+func (c *Converter) ToInt8Array(value interface{}, converters ...Int8ArrayConverter) []int8 {
+	res, _ := c.ToInt8ArrayE(value, converters...)
 	return res
 }
 
 // ToInt8ArrayOrDefault converts any type of value to an array of int8 or returns the provided default array if conversion fails.
 func ToInt8ArrayOrDefault(value interface{}, defaultValue []int8, converters ...Int8ArrayConverter) []int8 {
-	res, err := ToInt8ArrayE(value, converters...)
+	return std.ToInt8ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToInt8ArrayOrDefault is like the package-level ToInt8ArrayOrDefault but uses the options of c.
+func (c *Converter) ToInt8ArrayOrDefault(value interface{}, defaultValue []int8, converters ...Int8ArrayConverter) []int8 {
+	res, err := c.ToInt8ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1556,38 +2062,57 @@ func ToInt8ArrayOrDefault(value interface{}, defaultValue []int8, converters ...
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt16ArrayE(value interface{}, converters ...Int16ArrayConverter) ([]int16, error) {
+	return std.ToInt16ArrayE(value, converters...)
+}
+
+// ToInt16ArrayE is like the package-level ToInt16ArrayE but uses the options of c.
+func (c *Converter) ToInt16ArrayE(value interface{}, converters ...Int16ArrayConverter) ([]int16, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]int16](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]int16, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToInt16E(v.Index(i).Interface())
+			res, err := c.ToInt16E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]int16]())
 	}
 }
 
 // ToInt16Array converts any type of value to an array of int16, ignoring errors.
 func ToInt16Array(value interface{}, converters ...Int16ArrayConverter) []int16 {
-	res, _ := ToInt16ArrayE(value, converters...)
+	return std.ToInt16Array(value, converters...)
+}
+
+// ToInt16Array is like the package-level ToInt16Array but uses the options of c.
+func (c *Converter) ToInt16Array(value interface{}, converters ...Int16ArrayConverter) []int16 {
+	res, _ := c.ToInt16ArrayE(value, converters...)
 	return res
 }
 
 // ToInt16ArrayOrDefault converts any type of value to an array of int16 or returns the provided default array if conversion fails.
 func ToInt16ArrayOrDefault(value interface{}, defaultValue []int16, converters ...Int16ArrayConverter) []int16 {
-	res, err := ToInt16ArrayE(value, converters...)
+	return std.ToInt16ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToInt16ArrayOrDefault is like the package-level ToInt16ArrayOrDefault but uses the options of c.
+func (c *Converter) ToInt16ArrayOrDefault(value interface{}, defaultValue []int16, converters ...Int16ArrayConverter) []int16 {
+	res, err := c.ToInt16ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1604,38 +2129,57 @@ func ToInt16ArrayOrDefault(value interface{}, defaultValue []int16, converters .
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt32ArrayE(value interface{}, converters ...Int32ArrayConverter) ([]int32, error) {
+	return std.ToInt32ArrayE(value, converters...)
+}
+
+// ToInt32ArrayE is like the package-level ToInt32ArrayE but uses the options of c.
+func (c *Converter) ToInt32ArrayE(value interface{}, converters ...Int32ArrayConverter) ([]int32, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]int32](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]int32, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToInt32E(v.Index(i).Interface())
+			res, err := c.ToInt32E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]int32]())
 	}
 }
 
 // ToInt32Array converts any type of value to an array of int32, ignoring errors.
 func ToInt32Array(value interface{}, converters ...Int32ArrayConverter) []int32 {
-	res, _ := ToInt32ArrayE(value, converters...)
+	return std.ToInt32Array(value, converters...)
+}
+
+// ToInt32Array is like the package-level ToInt32Array but uses the options of c.
+func (c *Converter) ToInt32Array(value interface{}, converters ...Int32ArrayConverter) []int32 {
+	res, _ := c.ToInt32ArrayE(value, converters...)
 	return res
 }
 
 // ToInt32ArrayOrDefault converts any type of value to an array of int32 or returns the provided default array if conversion fails.
 func ToInt32ArrayOrDefault(value interface{}, defaultValue []int32, converters ...Int32ArrayConverter) []int32 {
-	res, err := ToInt32ArrayE(value, converters...)
+	return std.ToInt32ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToInt32ArrayOrDefault is like the package-level ToInt32ArrayOrDefault but uses the options of c.
+func (c *Converter) ToInt32ArrayOrDefault(value interface{}, defaultValue []int32, converters ...Int32ArrayConverter) []int32 {
+	res, err := c.ToInt32ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1652,38 +2196,57 @@ func ToInt32ArrayOrDefault(value interface{}, defaultValue []int32, converters .
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToInt64ArrayE(value interface{}, converters ...Int64ArrayConverter) ([]int64, error) {
+	return std.ToInt64ArrayE(value, converters...)
+}
+
+// ToInt64ArrayE is like the package-level ToInt64ArrayE but uses the options of c.
+func (c *Converter) ToInt64ArrayE(value interface{}, converters ...Int64ArrayConverter) ([]int64, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]int64](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]int64, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToInt64E(v.Index(i).Interface())
+			res, err := c.ToInt64E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]int64]())
 	}
 }
 
 // ToInt64Array converts any type of value to an array of int64, ignoring errors.
 func ToInt64Array(value interface{}, converters ...Int64ArrayConverter) []int64 {
-	res, _ := ToInt64ArrayE(value, converters...)
+	return std.ToInt64Array(value, converters...)
+}
+
+// ToInt64Array is like the package-level ToInt64Array but uses the options of c.
+func (c *Converter) ToInt64Array(value interface{}, converters ...Int64ArrayConverter) []int64 {
+	res, _ := c.ToInt64ArrayE(value, converters...)
 	return res
 }
 
 // ToInt64ArrayOrDefault converts any type of value to an array of int64 or returns the provided default array if conversion fails.
 func ToInt64ArrayOrDefault(value interface{}, defaultValue []int64, converters ...Int64ArrayConverter) []int64 {
-	res, err := ToInt64ArrayE(value, converters...)
+	return std.ToInt64ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToInt64ArrayOrDefault is like the package-level ToInt64ArrayOrDefault but uses the options of c.
+func (c *Converter) ToInt64ArrayOrDefault(value interface{}, defaultValue []int64, converters ...Int64ArrayConverter) []int64 {
+	res, err := c.ToInt64ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1700,39 +2263,58 @@ func ToInt64ArrayOrDefault(value interface{}, defaultValue []int64, converters .
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUintArrayE(value interface{}, converters ...UintArrayConverter) ([]uint, error) {
+	return std.ToUintArrayE(value, converters...)
+}
+
+// ToUintArrayE is like the package-level ToUintArrayE but uses the options of c.
+func (c *Converter) ToUintArrayE(value interface{}, converters ...UintArrayConverter) ([]uint, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]uint](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array,
 		reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]uint, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToUintE(v.Index(i).Interface())
+			res, err := c.ToUintE(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]uint]())
 	}
 }
 
 // ToUintArray converts any type of value to an array of uint, ignoring errors.
 func ToUintArray(value interface{}, converters ...UintArrayConverter) []uint {
-	res, _ := ToUintArrayE(value, converters...)
+	return std.ToUintArray(value, converters...)
+}
+
+// ToUintArray is like the package-level ToUintArray but uses the options of c.
+func (c *Converter) ToUintArray(value interface{}, converters ...UintArrayConverter) []uint {
+	res, _ := c.ToUintArrayE(value, converters...)
 	return res
 }
 
 // ToUintArrayOrDefault converts any type of value to an array of uint or returns the provided default array if conversion fails.
 func ToUintArrayOrDefault(value interface{}, defaultValue []uint, converters ...UintArrayConverter) []uint {
-	res, err := ToUintArrayE(value, converters...)
+	return std.ToUintArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToUintArrayOrDefault is like the package-level ToUintArrayOrDefault but uses the options of c.
+func (c *Converter) ToUintArrayOrDefault(value interface{}, defaultValue []uint, converters ...UintArrayConverter) []uint {
+	res, err := c.ToUintArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1749,38 +2331,57 @@ func ToUintArrayOrDefault(value interface{}, defaultValue []uint, converters ...
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint8ArrayE(value interface{}, converters ...Uint8ArrayConverter) ([]uint8, error) {
+	return std.ToUint8ArrayE(value, converters...)
+}
+
+// ToUint8ArrayE is like the package-level ToUint8ArrayE but uses the options of c.
+func (c *Converter) ToUint8ArrayE(value interface{}, converters ...Uint8ArrayConverter) ([]uint8, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]uint8](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]uint8, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToUint8E(v.Index(i).Interface())
+			res, err := c.ToUint8E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]uint8]())
 	}
 }
 
 // ToUint8Array converts any type of value to an array of uint8, ignoring errors.
 func ToUint8Array(value interface{}, converters ...Uint8ArrayConverter) []uint8 {
-	res, _ := ToUint8ArrayE(value, converters...)
+	return std.ToUint8Array(value, converters...)
+}
+
+// ToUint8Array is like the package-level ToUint8Array but uses the options of c.
+func (c *Converter) ToUint8Array(value interface{}, converters ...Uint8ArrayConverter) []uint8 {
+	res, _ := c.ToUint8ArrayE(value, converters...)
 	return res
 }
 
 // ToUint8ArrayOrDefault converts any type of value to an array of uint8 or returns the provided default array if conversion fails.
 func ToUint8ArrayOrDefault(value interface{}, defaultValue []uint8, converters ...Uint8ArrayConverter) []uint8 {
-	res, err := ToUint8ArrayE(value, converters...)
+	return std.ToUint8ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToUint8ArrayOrDefault is like the package-level ToUint8ArrayOrDefault but uses the options of c.
+func (c *Converter) ToUint8ArrayOrDefault(value interface{}, defaultValue []uint8, converters ...Uint8ArrayConverter) []uint8 {
+	res, err := c.ToUint8ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1797,38 +2398,57 @@ func ToUint8ArrayOrDefault(value interface{}, defaultValue []uint8, converters .
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint16ArrayE(value interface{}, converters ...Uint16ArrayConverter) ([]uint16, error) {
+	return std.ToUint16ArrayE(value, converters...)
+}
+
+// ToUint16ArrayE is like the package-level ToUint16ArrayE but uses the options of c.
+func (c *Converter) ToUint16ArrayE(value interface{}, converters ...Uint16ArrayConverter) ([]uint16, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]uint16](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]uint16, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToUint16E(v.Index(i).Interface())
+			res, err := c.ToUint16E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]uint16]())
 	}
 }
 
 // ToUint16Array converts any type of value to an array of uint16, ignoring errors.
 func ToUint16Array(value interface{}, converters ...Uint16ArrayConverter) []uint16 {
-	res, _ := ToUint16ArrayE(value, converters...)
+	return std.ToUint16Array(value, converters...)
+}
+
+// ToUint16Array is like the package-level ToUint16Array but uses the options of c.
+func (c *Converter) ToUint16Array(value interface{}, converters ...Uint16ArrayConverter) []uint16 {
+	res, _ := c.ToUint16ArrayE(value, converters...)
 	return res
 }
 
 // ToUint16ArrayOrDefault converts any type of value to an array of uint16 or returns the provided default array if conversion fails.
 func ToUint16ArrayOrDefault(value interface{}, defaultValue []uint16, converters ...Uint16ArrayConverter) []uint16 {
-	res, err := ToUint16ArrayE(value, converters...)
+	return std.ToUint16ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToUint16ArrayOrDefault is like the package-level ToUint16ArrayOrDefault but uses the options of c.
+func (c *Converter) ToUint16ArrayOrDefault(value interface{}, defaultValue []uint16, converters ...Uint16ArrayConverter) []uint16 {
+	res, err := c.ToUint16ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1845,38 +2465,57 @@ func ToUint16ArrayOrDefault(value interface{}, defaultValue []uint16, converters
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint32ArrayE(value interface{}, converters ...Uint32ArrayConverter) ([]uint32, error) {
+	return std.ToUint32ArrayE(value, converters...)
+}
+
+// ToUint32ArrayE is like the package-level ToUint32ArrayE but uses the options of c.
+func (c *Converter) ToUint32ArrayE(value interface{}, converters ...Uint32ArrayConverter) ([]uint32, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]uint32](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]uint32, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToUint32E(v.Index(i).Interface())
+			res, err := c.ToUint32E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]uint32]())
 	}
 }
 
 // ToUint32Array converts any type of value to an array of uint32, ignoring errors.
 func ToUint32Array(value interface{}, converters ...Uint32ArrayConverter) []uint32 {
-	res, _ := ToUint32ArrayE(value, converters...)
+	return std.ToUint32Array(value, converters...)
+}
+
+// ToUint32Array is like the package-level ToUint32Array but uses the options of c.
+func (c *Converter) ToUint32Array(value interface{}, converters ...Uint32ArrayConverter) []uint32 {
+	res, _ := c.ToUint32ArrayE(value, converters...)
 	return res
 }
 
 // ToUint32ArrayOrDefault converts any type of value to an array of uint32 or returns the provided default array if conversion fails.
 func ToUint32ArrayOrDefault(value interface{}, defaultValue []uint32, converters ...Uint32ArrayConverter) []uint32 {
-	res, err := ToUint32ArrayE(value, converters...)
+	return std.ToUint32ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToUint32ArrayOrDefault is like the package-level ToUint32ArrayOrDefault but uses the options of c.
+func (c *Converter) ToUint32ArrayOrDefault(value interface{}, defaultValue []uint32, converters ...Uint32ArrayConverter) []uint32 {
+	res, err := c.ToUint32ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1893,38 +2532,57 @@ func ToUint32ArrayOrDefault(value interface{}, defaultValue []uint32, converters
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToUint64ArrayE(value interface{}, converters ...Uint64ArrayConverter) ([]uint64, error) {
+	return std.ToUint64ArrayE(value, converters...)
+}
+
+// ToUint64ArrayE is like the package-level ToUint64ArrayE but uses the options of c.
+func (c *Converter) ToUint64ArrayE(value interface{}, converters ...Uint64ArrayConverter) ([]uint64, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]uint64](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]uint64, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToUint64E(v.Index(i).Interface())
+			res, err := c.ToUint64E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]uint64]())
 	}
 }
 
 // ToUint64Array converts any type of value to an array of uint64, ignoring errors.
 func ToUint64Array(value interface{}, converters ...Uint64ArrayConverter) []uint64 {
-	res, _ := ToUint64ArrayE(value, converters...)
+	return std.ToUint64Array(value, converters...)
+}
+
+// ToUint64Array is like the package-level ToUint64Array but uses the options of c.
+func (c *Converter) ToUint64Array(value interface{}, converters ...Uint64ArrayConverter) []uint64 {
+	res, _ := c.ToUint64ArrayE(value, converters...)
 	return res
 }
 
 // ToUint64ArrayOrDefault converts any type of value to an array of uint64 or returns the provided default array if conversion fails.
 func ToUint64ArrayOrDefault(value interface{}, defaultValue []uint64, converters ...Uint64ArrayConverter) []uint64 {
-	res, err := ToUint64ArrayE(value, converters...)
+	return std.ToUint64ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToUint64ArrayOrDefault is like the package-level ToUint64ArrayOrDefault but uses the options of c.
+func (c *Converter) ToUint64ArrayOrDefault(value interface{}, defaultValue []uint64, converters ...Uint64ArrayConverter) []uint64 {
+	res, err := c.ToUint64ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1941,41 +2599,60 @@ func ToUint64ArrayOrDefault(value interface{}, defaultValue []uint64, converters
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToFloat32ArrayE(value interface{}, converters ...Float32ArrayConverter) ([]float32, error) {
+	return std.ToFloat32ArrayE(value, converters...)
+}
+
+// ToFloat32ArrayE is like the package-level ToFloat32ArrayE but uses the options of c.
+func (c *Converter) ToFloat32ArrayE(value interface{}, converters ...Float32ArrayConverter) ([]float32, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]float32](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]float32, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToFloat32E(v.Index(i).Interface())
+			res, err := c.ToFloat32E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]float32]())
 	}
 }
 
 // ToFloat32Array converts any type of value to an array of float32, ignoring errors.
 func ToFloat32Array(value interface{}, converters ...Float32ArrayConverter) []float32 {
-	res, _ := ToFloat32ArrayE(value, converters...)
+	return std.ToFloat32Array(value, converters...)
+}
+
+// ToFloat32Array is like the package-level ToFloat32Array but uses the options of c.
+func (c *Converter) ToFloat32Array(value interface{}, converters ...Float32ArrayConverter) []float32 {
+	res, _ := c.ToFloat32ArrayE(value, converters...)
 	return res
 }
 
 // ToFloat32ArrayOrDefault converts any type of value to an array of float32 or returns the provided default array if conversion fails.
 func ToFloat32ArrayOrDefault(value interface{}, defaultValue []float32, converters ...Float32ArrayConverter) []float32 {
+	return std.ToFloat32ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToFloat32ArrayOrDefault is like the package-level ToFloat32ArrayOrDefault but uses the options of c.
+func (c *Converter) ToFloat32ArrayOrDefault(value interface{}, defaultValue []float32, converters ...Float32ArrayConverter) []float32 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToFloat32ArrayE(value, converters...)
+	res, err := c.ToFloat32ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -1992,41 +2669,60 @@ func ToFloat32ArrayOrDefault(value interface{}, defaultValue []float32, converte
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 func ToFloat64ArrayE(value interface{}, converters ...Float64ArrayConverter) ([]float64, error) {
+	return std.ToFloat64ArrayE(value, converters...)
+}
+
+// ToFloat64ArrayE is like the package-level ToFloat64ArrayE but uses the options of c.
+func (c *Converter) ToFloat64ArrayE(value interface{}, converters ...Float64ArrayConverter) ([]float64, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	switch reflect.TypeOf(value).Kind() {
+	if res, ok, err := fromRegistry[[]float64](value); ok {
+		return res, err
+	}
+
+	switch reflect.ValueOf(value).Kind() {
 	case reflect.Array, reflect.Slice:
 		v := reflect.ValueOf(value)
 		resArray := make([]float64, v.Len())
 		for i := 0; i < v.Len(); i++ {
-			res, err := ToFloat64E(v.Index(i).Interface())
+			res, err := c.ToFloat64E(v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("convert: cannot convert %v at index %d", v.Index(i).Interface(), i)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeOf(res))
 			}
 			resArray[i] = res
 		}
 		return resArray, nil
 	default:
-		return nil, fmt.Errorf("convert: %T is not an array or slice", value)
+		return nil, unsupportedError(value, reflect.TypeFor[[]float64]())
 	}
 }
 
 // ToFloat64Array converts any type of value to an array of float64, ignoring errors.
 func ToFloat64Array(value interface{}, converters ...Float64ArrayConverter) []float64 {
-	res, _ := ToFloat64ArrayE(value, converters...)
+	return std.ToFloat64Array(value, converters...)
+}
+
+// ToFloat64Array is like the package-level ToFloat64Array but uses the options of c.
+func (c *Converter) ToFloat64Array(value interface{}, converters ...Float64ArrayConverter) []float64 {
+	res, _ := c.ToFloat64ArrayE(value, converters...)
 	return res
 }
 
 // ToFloat64ArrayOrDefault converts any type of value to an array of float64 or returns the provided default array if conversion fails.
 func ToFloat64ArrayOrDefault(value interface{}, defaultValue []float64, converters ...Float64ArrayConverter) []float64 {
+	return std.ToFloat64ArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToFloat64ArrayOrDefault is like the package-level ToFloat64ArrayOrDefault but uses the options of c.
+func (c *Converter) ToFloat64ArrayOrDefault(value interface{}, defaultValue []float64, converters ...Float64ArrayConverter) []float64 {
 	if value == nil {
 		return defaultValue
 	}
-	res, err := ToFloat64ArrayE(value, converters...)
+	res, err := c.ToFloat64ArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
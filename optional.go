@@ -0,0 +1,196 @@
+package convert
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+)
+
+// optionalSetter is implemented by *Optional[T], so that ToValueE and Decode
+// can fill Optional fields of any T with the options of a Converter.
+type optionalSetter interface {
+	setFrom(c *Converter, value interface{}) error
+}
+
+var optionalSetterType = reflect.TypeOf((*optionalSetter)(nil)).Elem()
+
+// Optional holds a value of type T that may be absent, explicitly null or set.
+// It tells apart the three states of a PATCH field:
+//   - the zero Optional is absent: Present and Valid are false
+//   - Null[T]() is an explicit null: Present is true and Valid is false
+//   - Some(v) holds v in V: Present and Valid are true
+//
+// Optional implements json.Marshaler and json.Unmarshaler, where null is an explicit null
+// and a missing field stays absent, and driver.Valuer and sql.Scanner, where NULL is an
+// explicit null. Marshalling an absent Optional gives null, like a nil pointer, since
+// the omitempty tag option does not apply to structs; IsZero reports an absent Optional.
+//
+// Example:
+//
+//	var patch struct {
+//		Age convert.Optional[int] `json:"age"`
+//	}
+//	_ = json.Unmarshal([]byte(`{"age": null}`), &patch)
+//	fmt.Println(patch.Age.Present, patch.Age.Valid) // Output: true false
+type Optional[T any] struct {
+	V       T
+	Valid   bool
+	Present bool
+}
+
+// Some returns an Optional holding v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{V: v, Valid: true, Present: true}
+}
+
+// Null returns an Optional holding an explicit null.
+func Null[T any]() Optional[T] {
+	return Optional[T]{Present: true}
+}
+
+// IsZero reports whether o is absent. Since Go 1.24, encoding/json uses it for the omitzero tag option.
+func (o Optional[T]) IsZero() bool {
+	return !o.Present
+}
+
+// IsNull reports whether o holds an explicit null.
+func (o Optional[T]) IsNull() bool {
+	return o.Present && !o.Valid
+}
+
+// Get returns the value of o and whether it is set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.V, o.Valid
+}
+
+// OrElse returns the value of o if it is set, or defaultValue otherwise.
+func (o Optional[T]) OrElse(defaultValue T) T {
+	if !o.Valid {
+		return defaultValue
+	}
+	return o.V
+}
+
+// Ptr returns a pointer to a copy of the value of o, or nil if it is not set.
+func (o Optional[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	return ToPtr(o.V)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	*o = Optional[T]{Present: true}
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	if err := json.Unmarshal(data, &o.V); err != nil {
+		return err
+	}
+	o.Valid = true
+	return nil
+}
+
+// Value implements driver.Valuer. Unset values are stored as NULL.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+	return ToDriverValueE(o.V)
+}
+
+// Scan implements sql.Scanner. NULL is scanned as an explicit null,
+// other values are converted to T with ToE.
+func (o *Optional[T]) Scan(src interface{}) error {
+	*o = Optional[T]{Present: true}
+	if src == nil {
+		return nil
+	}
+	v, err := ToE[T](src)
+	if err != nil {
+		return err
+	}
+	o.V = v
+	o.Valid = true
+	return nil
+}
+
+// ToOptional converts any type of value to an Optional[T], ignoring errors.
+func ToOptional[T any](value interface{}) Optional[T] {
+	res, _ := ToOptionalE[T](value)
+	return res
+}
+
+// ToOptionalE converts any type of value to an Optional[T] or returns an error.
+// Nil values, nil pointers and null database values give an explicit null,
+// an Optional[T] is returned as is, and other values are converted to T with ToE.
+//
+// Example:
+//
+//	age, err := convert.ToOptionalE[int](body["age"])
+//	if age.IsNull() {
+//		// clear the age
+//	}
+func ToOptionalE[T any](value interface{}) (Optional[T], error) {
+	return ToOptionalWithE[T](std, value)
+}
+
+// ToOptionalWith is like ToOptional but uses the options of c.
+func ToOptionalWith[T any](c *Converter, value interface{}) Optional[T] {
+	res, _ := ToOptionalWithE[T](c, value)
+	return res
+}
+
+// ToOptionalWithE is like ToOptionalE but uses the options of c.
+func ToOptionalWithE[T any](c *Converter, value interface{}) (Optional[T], error) {
+	switch o := value.(type) {
+	case Optional[T]:
+		return o, nil
+	case *Optional[T]:
+		if o != nil {
+			return *o, nil
+		}
+	}
+	if isNilValue(value) {
+		return Null[T](), nil
+	}
+	res, err := ToWithE[T](c, value)
+	if err != nil {
+		return Optional[T]{}, err
+	}
+	return Some(res), nil
+}
+
+// setFrom implements optionalSetter.
+func (o *Optional[T]) setFrom(c *Converter, value interface{}) error {
+	res, err := ToOptionalWithE[T](c, value)
+	if err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+// isOptional reports whether t is an Optional type.
+func isOptional(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(optionalSetterType)
+}
+
+// toOptional converts value to a new value of the Optional type to.
+func (c *Converter) toOptional(value interface{}, to reflect.Type) (reflect.Value, error) {
+	ptr := reflect.New(to)
+	if err := ptr.Interface().(optionalSetter).setFrom(c, value); err != nil {
+		return InvalidValue, err
+	}
+	return ptr.Elem(), nil
+}
@@ -0,0 +1,126 @@
+package convert
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type optionalPatch struct {
+	Name Optional[string]    `json:"name" convert:"name"`
+	Age  Optional[int]       `json:"age" convert:"age"`
+	Tags Optional[[]string]  `json:"tags" convert:"tags"`
+	Seen Optional[time.Time] `json:"seen" convert:"seen"`
+}
+
+func TestOptionalStates(t *testing.T) {
+	var absent Optional[int]
+	assert.True(t, absent.IsZero())
+	assert.False(t, absent.IsNull())
+	assert.Equal(t, 3, absent.OrElse(3))
+
+	null := Null[int]()
+	assert.False(t, null.IsZero())
+	assert.True(t, null.IsNull())
+	assert.Nil(t, null.Ptr())
+
+	some := Some(5)
+	v, ok := some.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 5, v)
+	assert.Equal(t, 5, some.OrElse(3))
+	assert.Equal(t, ToPtr(5), some.Ptr())
+}
+
+func TestOptionalJSON(t *testing.T) {
+	var patch optionalPatch
+	err := json.Unmarshal([]byte(`{"name": null, "tags": ["a"]}`), &patch)
+	assert.NoError(t, err)
+	assert.Equal(t, Null[string](), patch.Name)
+	assert.Equal(t, Optional[int]{}, patch.Age)
+	assert.Equal(t, Some([]string{"a"}), patch.Tags)
+
+	err = json.Unmarshal([]byte(`{"age": "x"}`), &patch)
+	assert.Error(t, err)
+
+	b, err := json.Marshal(optionalPatch{Name: Some("Ada"), Age: Null[int]()})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name": "Ada", "age": null, "tags": null, "seen": null}`, string(b))
+}
+
+func TestOptionalSQL(t *testing.T) {
+	v, err := Some(uint16(8)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), v)
+
+	v, err = Null[string]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var o Optional[int]
+	assert.NoError(t, o.Scan(int64(4)))
+	assert.Equal(t, Some(4), o)
+	assert.NoError(t, o.Scan(nil))
+	assert.Equal(t, Null[int](), o)
+	assert.ErrorIs(t, o.Scan("x"), ErrSyntax)
+
+	assert.Equal(t, 4, ToInt(Some("4")))
+	assert.Equal(t, "", ToString(Null[string]()))
+}
+
+func TestToOptional(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected Optional[int]
+		err      error
+	}{
+		{nil, Null[int](), nil},
+		{(*string)(nil), Null[int](), nil},
+		{sql.NullString{}, Null[int](), nil},
+		{"12", Some(12), nil},
+		{0, Some(0), nil},
+		{Some(3), Some(3), nil},
+		{Optional[int]{}, Optional[int]{}, nil},
+		{Some("7"), Some(7), nil},
+		{"abc", Optional[int]{}, ErrSyntax},
+	}
+
+	for _, test := range tests {
+		res, err := ToOptionalE[int](test.input)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %#v", test.input)
+		} else {
+			assert.NoError(t, err, "input %#v", test.input)
+		}
+		assert.Equal(t, test.expected, res, "input %#v", test.input)
+	}
+
+	assert.Equal(t, Optional[int]{}, ToOptionalWith[int](New(WithEmptyStringAsZero(false)), ""))
+	assert.Equal(t, Some([]int{1, 2}), ToOptional[[]int]([]string{"1", "2"}))
+}
+
+func TestOptionalTargets(t *testing.T) {
+	v, err := ToValueE(nil, reflect.TypeOf(Optional[int]{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, Null[int](), v.Interface())
+	}
+
+	o, err := ToE[Optional[float64]]("1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, Some(1.5), o)
+
+	var patch optionalPatch
+	err = Decode(map[string]interface{}{"name": nil, "age": "36", "tags": []interface{}{"a", 1}}, &patch)
+	assert.NoError(t, err)
+	assert.Equal(t, optionalPatch{Name: Null[string](), Age: Some(36), Tags: Some([]string{"a", "1"})}, patch)
+
+	err = Decode(map[string]interface{}{"age": "x"}, &patch)
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, "Age", convErr.Path)
+	}
+}
@@ -1,6 +1,9 @@
 package convert
 
-import "reflect"
+import (
+	"reflect"
+	"time"
+)
 
 // Indirect dereferences the given value if it's a pointer.
 // If v is not a pointer, it returns v as is.
@@ -34,3 +37,411 @@ func Indirect(v any) any {
 func ToPtr[T any](v T) *T {
 	return &v
 }
+
+// isNilValue reports whether value is nil, a nil pointer or a null database value,
+// like an invalid sql.NullInt64.
+func isNilValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if rv := reflect.ValueOf(Indirect(value)); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return true
+	}
+	return isNullValuer(value)
+}
+
+// toPtrE converts value to a *T with c, keeping nil values as a nil pointer.
+func toPtrE[T any](c *Converter, value interface{}) (*T, error) {
+	if isNilValue(value) {
+		return nil, nil
+	}
+	res, err := ToWithE[T](c, value)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ToBoolPtr converts any type of value to a *bool, ignoring errors.
+// Nil values give a nil pointer.
+func ToBoolPtr(value interface{}) *bool {
+	return std.ToBoolPtr(value)
+}
+
+// ToBoolPtr is like the package-level ToBoolPtr but uses the options of c.
+func (c *Converter) ToBoolPtr(value interface{}) *bool {
+	res, _ := c.ToBoolPtrE(value)
+	return res
+}
+
+// ToBoolPtrE converts any type of value to a *bool or returns an error.
+// Unlike ToBoolE, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToBoolPtrE(value interface{}) (*bool, error) {
+	return std.ToBoolPtrE(value)
+}
+
+// ToBoolPtrE is like the package-level ToBoolPtrE but uses the options of c.
+func (c *Converter) ToBoolPtrE(value interface{}) (*bool, error) {
+	return toPtrE[bool](c, value)
+}
+
+// ToIntPtr converts any type of value to a *int, ignoring errors.
+// Nil values give a nil pointer.
+func ToIntPtr(value interface{}) *int {
+	return std.ToIntPtr(value)
+}
+
+// ToIntPtr is like the package-level ToIntPtr but uses the options of c.
+func (c *Converter) ToIntPtr(value interface{}) *int {
+	res, _ := c.ToIntPtrE(value)
+	return res
+}
+
+// ToIntPtrE converts any type of value to a *int or returns an error.
+// Unlike ToIntE, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToIntPtrE(value interface{}) (*int, error) {
+	return std.ToIntPtrE(value)
+}
+
+// ToIntPtrE is like the package-level ToIntPtrE but uses the options of c.
+func (c *Converter) ToIntPtrE(value interface{}) (*int, error) {
+	return toPtrE[int](c, value)
+}
+
+// ToInt8Ptr converts any type of value to a *int8, ignoring errors.
+// Nil values give a nil pointer.
+func ToInt8Ptr(value interface{}) *int8 {
+	return std.ToInt8Ptr(value)
+}
+
+// ToInt8Ptr is like the package-level ToInt8Ptr but uses the options of c.
+func (c *Converter) ToInt8Ptr(value interface{}) *int8 {
+	res, _ := c.ToInt8PtrE(value)
+	return res
+}
+
+// ToInt8PtrE converts any type of value to a *int8 or returns an error.
+// Unlike ToInt8E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToInt8PtrE(value interface{}) (*int8, error) {
+	return std.ToInt8PtrE(value)
+}
+
+// ToInt8PtrE is like the package-level ToInt8PtrE but uses the options of c.
+func (c *Converter) ToInt8PtrE(value interface{}) (*int8, error) {
+	return toPtrE[int8](c, value)
+}
+
+// ToInt16Ptr converts any type of value to a *int16, ignoring errors.
+// Nil values give a nil pointer.
+func ToInt16Ptr(value interface{}) *int16 {
+	return std.ToInt16Ptr(value)
+}
+
+// ToInt16Ptr is like the package-level ToInt16Ptr but uses the options of c.
+func (c *Converter) ToInt16Ptr(value interface{}) *int16 {
+	res, _ := c.ToInt16PtrE(value)
+	return res
+}
+
+// ToInt16PtrE converts any type of value to a *int16 or returns an error.
+// Unlike ToInt16E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToInt16PtrE(value interface{}) (*int16, error) {
+	return std.ToInt16PtrE(value)
+}
+
+// ToInt16PtrE is like the package-level ToInt16PtrE but uses the options of c.
+func (c *Converter) ToInt16PtrE(value interface{}) (*int16, error) {
+	return toPtrE[int16](c, value)
+}
+
+// ToInt32Ptr converts any type of value to a *int32, ignoring errors.
+// Nil values give a nil pointer.
+func ToInt32Ptr(value interface{}) *int32 {
+	return std.ToInt32Ptr(value)
+}
+
+// ToInt32Ptr is like the package-level ToInt32Ptr but uses the options of c.
+func (c *Converter) ToInt32Ptr(value interface{}) *int32 {
+	res, _ := c.ToInt32PtrE(value)
+	return res
+}
+
+// ToInt32PtrE converts any type of value to a *int32 or returns an error.
+// Unlike ToInt32E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToInt32PtrE(value interface{}) (*int32, error) {
+	return std.ToInt32PtrE(value)
+}
+
+// ToInt32PtrE is like the package-level ToInt32PtrE but uses the options of c.
+func (c *Converter) ToInt32PtrE(value interface{}) (*int32, error) {
+	return toPtrE[int32](c, value)
+}
+
+// ToInt64Ptr converts any type of value to a *int64, ignoring errors.
+// Nil values give a nil pointer.
+func ToInt64Ptr(value interface{}) *int64 {
+	return std.ToInt64Ptr(value)
+}
+
+// ToInt64Ptr is like the package-level ToInt64Ptr but uses the options of c.
+func (c *Converter) ToInt64Ptr(value interface{}) *int64 {
+	res, _ := c.ToInt64PtrE(value)
+	return res
+}
+
+// ToInt64PtrE converts any type of value to a *int64 or returns an error.
+// Unlike ToInt64E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToInt64PtrE(value interface{}) (*int64, error) {
+	return std.ToInt64PtrE(value)
+}
+
+// ToInt64PtrE is like the package-level ToInt64PtrE but uses the options of c.
+func (c *Converter) ToInt64PtrE(value interface{}) (*int64, error) {
+	return toPtrE[int64](c, value)
+}
+
+// ToUintPtr converts any type of value to a *uint, ignoring errors.
+// Nil values give a nil pointer.
+func ToUintPtr(value interface{}) *uint {
+	return std.ToUintPtr(value)
+}
+
+// ToUintPtr is like the package-level ToUintPtr but uses the options of c.
+func (c *Converter) ToUintPtr(value interface{}) *uint {
+	res, _ := c.ToUintPtrE(value)
+	return res
+}
+
+// ToUintPtrE converts any type of value to a *uint or returns an error.
+// Unlike ToUintE, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToUintPtrE(value interface{}) (*uint, error) {
+	return std.ToUintPtrE(value)
+}
+
+// ToUintPtrE is like the package-level ToUintPtrE but uses the options of c.
+func (c *Converter) ToUintPtrE(value interface{}) (*uint, error) {
+	return toPtrE[uint](c, value)
+}
+
+// ToUint8Ptr converts any type of value to a *uint8, ignoring errors.
+// Nil values give a nil pointer.
+func ToUint8Ptr(value interface{}) *uint8 {
+	return std.ToUint8Ptr(value)
+}
+
+// ToUint8Ptr is like the package-level ToUint8Ptr but uses the options of c.
+func (c *Converter) ToUint8Ptr(value interface{}) *uint8 {
+	res, _ := c.ToUint8PtrE(value)
+	return res
+}
+
+// ToUint8PtrE converts any type of value to a *uint8 or returns an error.
+// Unlike ToUint8E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToUint8PtrE(value interface{}) (*uint8, error) {
+	return std.ToUint8PtrE(value)
+}
+
+// ToUint8PtrE is like the package-level ToUint8PtrE but uses the options of c.
+func (c *Converter) ToUint8PtrE(value interface{}) (*uint8, error) {
+	return toPtrE[uint8](c, value)
+}
+
+// ToUint16Ptr converts any type of value to a *uint16, ignoring errors.
+// Nil values give a nil pointer.
+func ToUint16Ptr(value interface{}) *uint16 {
+	return std.ToUint16Ptr(value)
+}
+
+// ToUint16Ptr is like the package-level ToUint16Ptr but uses the options of c.
+func (c *Converter) ToUint16Ptr(value interface{}) *uint16 {
+	res, _ := c.ToUint16PtrE(value)
+	return res
+}
+
+// ToUint16PtrE converts any type of value to a *uint16 or returns an error.
+// Unlike ToUint16E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToUint16PtrE(value interface{}) (*uint16, error) {
+	return std.ToUint16PtrE(value)
+}
+
+// ToUint16PtrE is like the package-level ToUint16PtrE but uses the options of c.
+func (c *Converter) ToUint16PtrE(value interface{}) (*uint16, error) {
+	return toPtrE[uint16](c, value)
+}
+
+// ToUint32Ptr converts any type of value to a *uint32, ignoring errors.
+// Nil values give a nil pointer.
+func ToUint32Ptr(value interface{}) *uint32 {
+	return std.ToUint32Ptr(value)
+}
+
+// ToUint32Ptr is like the package-level ToUint32Ptr but uses the options of c.
+func (c *Converter) ToUint32Ptr(value interface{}) *uint32 {
+	res, _ := c.ToUint32PtrE(value)
+	return res
+}
+
+// ToUint32PtrE converts any type of value to a *uint32 or returns an error.
+// Unlike ToUint32E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToUint32PtrE(value interface{}) (*uint32, error) {
+	return std.ToUint32PtrE(value)
+}
+
+// ToUint32PtrE is like the package-level ToUint32PtrE but uses the options of c.
+func (c *Converter) ToUint32PtrE(value interface{}) (*uint32, error) {
+	return toPtrE[uint32](c, value)
+}
+
+// ToUint64Ptr converts any type of value to a *uint64, ignoring errors.
+// Nil values give a nil pointer.
+func ToUint64Ptr(value interface{}) *uint64 {
+	return std.ToUint64Ptr(value)
+}
+
+// ToUint64Ptr is like the package-level ToUint64Ptr but uses the options of c.
+func (c *Converter) ToUint64Ptr(value interface{}) *uint64 {
+	res, _ := c.ToUint64PtrE(value)
+	return res
+}
+
+// ToUint64PtrE converts any type of value to a *uint64 or returns an error.
+// Unlike ToUint64E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToUint64PtrE(value interface{}) (*uint64, error) {
+	return std.ToUint64PtrE(value)
+}
+
+// ToUint64PtrE is like the package-level ToUint64PtrE but uses the options of c.
+func (c *Converter) ToUint64PtrE(value interface{}) (*uint64, error) {
+	return toPtrE[uint64](c, value)
+}
+
+// ToFloat32Ptr converts any type of value to a *float32, ignoring errors.
+// Nil values give a nil pointer.
+func ToFloat32Ptr(value interface{}) *float32 {
+	return std.ToFloat32Ptr(value)
+}
+
+// ToFloat32Ptr is like the package-level ToFloat32Ptr but uses the options of c.
+func (c *Converter) ToFloat32Ptr(value interface{}) *float32 {
+	res, _ := c.ToFloat32PtrE(value)
+	return res
+}
+
+// ToFloat32PtrE converts any type of value to a *float32 or returns an error.
+// Unlike ToFloat32E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToFloat32PtrE(value interface{}) (*float32, error) {
+	return std.ToFloat32PtrE(value)
+}
+
+// ToFloat32PtrE is like the package-level ToFloat32PtrE but uses the options of c.
+func (c *Converter) ToFloat32PtrE(value interface{}) (*float32, error) {
+	return toPtrE[float32](c, value)
+}
+
+// ToFloat64Ptr converts any type of value to a *float64, ignoring errors.
+// Nil values give a nil pointer.
+func ToFloat64Ptr(value interface{}) *float64 {
+	return std.ToFloat64Ptr(value)
+}
+
+// ToFloat64Ptr is like the package-level ToFloat64Ptr but uses the options of c.
+func (c *Converter) ToFloat64Ptr(value interface{}) *float64 {
+	res, _ := c.ToFloat64PtrE(value)
+	return res
+}
+
+// ToFloat64PtrE converts any type of value to a *float64 or returns an error.
+// Unlike ToFloat64E, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToFloat64PtrE(value interface{}) (*float64, error) {
+	return std.ToFloat64PtrE(value)
+}
+
+// ToFloat64PtrE is like the package-level ToFloat64PtrE but uses the options of c.
+func (c *Converter) ToFloat64PtrE(value interface{}) (*float64, error) {
+	return toPtrE[float64](c, value)
+}
+
+// ToStringPtr converts any type of value to a *string, ignoring errors.
+// Nil values give a nil pointer.
+func ToStringPtr(value interface{}) *string {
+	return std.ToStringPtr(value)
+}
+
+// ToStringPtr is like the package-level ToStringPtr but uses the options of c.
+func (c *Converter) ToStringPtr(value interface{}) *string {
+	res, _ := c.ToStringPtrE(value)
+	return res
+}
+
+// ToStringPtrE converts any type of value to a *string or returns an error.
+// Unlike ToStringE, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToStringPtrE(value interface{}) (*string, error) {
+	return std.ToStringPtrE(value)
+}
+
+// ToStringPtrE is like the package-level ToStringPtrE but uses the options of c.
+func (c *Converter) ToStringPtrE(value interface{}) (*string, error) {
+	return toPtrE[string](c, value)
+}
+
+// ToTimePtr converts any type of value to a *time.Time, ignoring errors.
+// Nil values give a nil pointer.
+func ToTimePtr(value interface{}) *time.Time {
+	return std.ToTimePtr(value)
+}
+
+// ToTimePtr is like the package-level ToTimePtr but uses the options of c.
+func (c *Converter) ToTimePtr(value interface{}) *time.Time {
+	res, _ := c.ToTimePtrE(value)
+	return res
+}
+
+// ToTimePtrE converts any type of value to a *time.Time or returns an error.
+// Unlike ToTimeE, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToTimePtrE(value interface{}) (*time.Time, error) {
+	return std.ToTimePtrE(value)
+}
+
+// ToTimePtrE is like the package-level ToTimePtrE but uses the options of c.
+func (c *Converter) ToTimePtrE(value interface{}) (*time.Time, error) {
+	return toPtrE[time.Time](c, value)
+}
+
+// ToDurationPtr converts any type of value to a *time.Duration, ignoring errors.
+// Nil values give a nil pointer.
+func ToDurationPtr(value interface{}) *time.Duration {
+	return std.ToDurationPtr(value)
+}
+
+// ToDurationPtr is like the package-level ToDurationPtr but uses the options of c.
+func (c *Converter) ToDurationPtr(value interface{}) *time.Duration {
+	res, _ := c.ToDurationPtrE(value)
+	return res
+}
+
+// ToDurationPtrE converts any type of value to a *time.Duration or returns an error.
+// Unlike ToDurationE, nil values, nil pointers and null database values give nil, nil,
+// so that an absent value can be told from a zero one.
+func ToDurationPtrE(value interface{}) (*time.Duration, error) {
+	return std.ToDurationPtrE(value)
+}
+
+// ToDurationPtrE is like the package-level ToDurationPtrE but uses the options of c.
+func (c *Converter) ToDurationPtrE(value interface{}) (*time.Duration, error) {
+	return toPtrE[time.Duration](c, value)
+}
@@ -1,8 +1,10 @@
 package convert
 
 import (
+	"database/sql"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -154,3 +156,37 @@ func TestToPtrString(t *testing.T) {
 		assert.Equal(t, value, *result, "Pointed value should be equal to the original value")
 	})
 }
+
+// TestToPtrFamily tests the nil-preserving pointer converters
+func TestToPtrFamily(t *testing.T) {
+	t.Run("Nil values", func(t *testing.T) {
+		for _, input := range []interface{}{nil, (*int)(nil), (**string)(nil), sql.NullInt64{}} {
+			n, err := ToIntPtrE(input)
+			assert.NoError(t, err)
+			assert.Nil(t, n, "input %#v", input)
+		}
+		assert.Nil(t, ToBoolPtr(nil))
+		assert.Nil(t, ToTimePtr(nil))
+	})
+
+	t.Run("Converted values", func(t *testing.T) {
+		n, err := ToIntPtrE("42")
+		assert.NoError(t, err)
+		assert.Equal(t, ToPtr(42), n)
+
+		assert.Equal(t, ToPtr(false), ToBoolPtr("false"))
+		assert.Equal(t, ToPtr(uint8(7)), ToUint8Ptr(ToPtr(7)))
+		assert.Equal(t, ToPtr(""), ToStringPtr(""))
+		assert.Equal(t, ToPtr(time.Minute), ToDurationPtr("1m"))
+		assert.Equal(t, ToPtr(int64(5)), ToInt64Ptr(sql.NullInt64{Int64: 5, Valid: true}))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		n, err := ToInt8PtrE("300")
+		assert.ErrorIs(t, err, ErrOverflow)
+		assert.Nil(t, n)
+
+		_, err = New(WithEmptyStringAsZero(false)).ToFloat64PtrE("")
+		assert.ErrorIs(t, err, ErrSyntax)
+	})
+}
@@ -0,0 +1,382 @@
+package convert
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// radixPrefixes are the prefixes of the bases that Go literals can use.
+var radixPrefixes = map[int]string{2: "0b", 8: "0o", 16: "0x"}
+
+// WithIntegerBase sets the base in which the integer converters and ToBigIntE parse strings.
+// The default, 0, reads the base from the prefix of the string like strconv.ParseInt does:
+// "0x1f" is hexadecimal, "0b101" binary, and "010" or "0o10" octal.
+// Use WithIntegerBase(10) to parse decimal numbers only, so that zero-padded
+// identifiers like "010" give 10. With the bases 2, 8 and 16, the matching prefix is optional.
+// With an explicit base, strings must be integers in that base: floats like "1.5",
+// booleans and digits invalid in the base, like "12" in base 2, are reported as ErrSyntax.
+// ToBigFloatE and ToBigRatE read the integers in the base too, but parse other strings
+// as decimal numbers. ToDecimalE and the float converters ignore the base and always
+// parse decimal numbers. Bases outside 2 to 36 are treated as 0.
+func WithIntegerBase(base int) Option {
+	return func(o *options) {
+		if base < 2 || base > 36 {
+			base = 0
+		}
+		o.integerBase = base
+	}
+}
+
+// parseInt parses s as an int64 in the integer base of c.
+func (c *Converter) parseInt(s string) (int64, error) {
+	return strconv.ParseInt(c.baseDigits(s), c.opts.integerBase, 64)
+}
+
+// parseUint parses s as a uint64 in the integer base of c.
+func (c *Converter) parseUint(s string) (uint64, error) {
+	return strconv.ParseUint(c.baseDigits(s), c.opts.integerBase, 64)
+}
+
+// parseBigInt parses s as a *big.Int in the integer base of c.
+func (c *Converter) parseBigInt(s string) (*big.Int, bool) {
+	return new(big.Int).SetString(c.baseDigits(s), c.opts.integerBase)
+}
+
+// radixError returns the error for the string s, read from value, which is not an integer
+// of the type to in the integer base of c: ErrOverflow when its digits are valid, and
+// ErrSyntax otherwise.
+func (c *Converter) radixError(value interface{}, s string, to reflect.Type) error {
+	if n, ok := c.parseBigInt(s); ok {
+		return overflowError(value, to, n.Sign())
+	}
+	return newError(s, to, ErrSyntax)
+}
+
+// baseDigits removes from s the prefix of the integer base of c, like "0x" for base 16,
+// keeping its sign.
+func (c *Converter) baseDigits(s string) string {
+	prefix, ok := radixPrefixes[c.opts.integerBase]
+	if !ok {
+		return s
+	}
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	if len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		s = s[len(prefix):]
+	}
+	return sign + s
+}
+
+// baseError returns the error for a base outside 2 to 36.
+func baseError(value interface{}, to reflect.Type, base int) error {
+	return newError(value, to, fmt.Errorf("%w: base %d", ErrUnsupported, base))
+}
+
+// toIntegerBase converts value to the integer type T, parsing strings in the given base.
+func toIntegerBase[T integer](c *Converter, value interface{}, base int) (T, error) {
+	if base < 2 || base > 36 {
+		return 0, baseError(value, reflect.TypeFor[T](), base)
+	}
+	return ToWithE[T](c.With(WithIntegerBase(base)), value)
+}
+
+// ToIntBase converts any type of value to int, parsing strings in the given base, ignoring errors.
+func ToIntBase(value interface{}, base int) int {
+	return std.ToIntBase(value, base)
+}
+
+// ToIntBase is like the package-level ToIntBase but uses the options of c.
+func (c *Converter) ToIntBase(value interface{}, base int) int {
+	res, _ := c.ToIntBaseE(value, base)
+	return res
+}
+
+// ToIntBaseE converts any type of value to int or returns an error, like ToIntE,
+// but parses strings in the given base, between 2 and 36: "ff" in base 16 gives 255,
+// and "010" in base 10 gives 10. With the bases 2, 8 and 16, the prefix "0b", "0o"
+// or "0x" is optional. Strings that are not integers in the base, like "12" in base 2
+// or "1.5", are reported as ErrSyntax, and other bases as ErrUnsupported.
+//
+// Example:
+//
+//	n, err := ToIntBaseE("ff", 16)
+//	fmt.Println(n) // Output: 255
+func ToIntBaseE(value interface{}, base int) (int, error) {
+	return std.ToIntBaseE(value, base)
+}
+
+// ToIntBaseE is like the package-level ToIntBaseE but uses the options of c.
+func (c *Converter) ToIntBaseE(value interface{}, base int) (int, error) {
+	return toIntegerBase[int](c, value, base)
+}
+
+// ToInt8Base converts any type of value to int8, parsing strings in the given base, ignoring errors.
+func ToInt8Base(value interface{}, base int) int8 {
+	return std.ToInt8Base(value, base)
+}
+
+// ToInt8Base is like the package-level ToInt8Base but uses the options of c.
+func (c *Converter) ToInt8Base(value interface{}, base int) int8 {
+	res, _ := c.ToInt8BaseE(value, base)
+	return res
+}
+
+// ToInt8BaseE converts any type of value to int8 or returns an error, like ToInt8E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToInt8BaseE(value interface{}, base int) (int8, error) {
+	return std.ToInt8BaseE(value, base)
+}
+
+// ToInt8BaseE is like the package-level ToInt8BaseE but uses the options of c.
+func (c *Converter) ToInt8BaseE(value interface{}, base int) (int8, error) {
+	return toIntegerBase[int8](c, value, base)
+}
+
+// ToInt16Base converts any type of value to int16, parsing strings in the given base, ignoring errors.
+func ToInt16Base(value interface{}, base int) int16 {
+	return std.ToInt16Base(value, base)
+}
+
+// ToInt16Base is like the package-level ToInt16Base but uses the options of c.
+func (c *Converter) ToInt16Base(value interface{}, base int) int16 {
+	res, _ := c.ToInt16BaseE(value, base)
+	return res
+}
+
+// ToInt16BaseE converts any type of value to int16 or returns an error, like ToInt16E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToInt16BaseE(value interface{}, base int) (int16, error) {
+	return std.ToInt16BaseE(value, base)
+}
+
+// ToInt16BaseE is like the package-level ToInt16BaseE but uses the options of c.
+func (c *Converter) ToInt16BaseE(value interface{}, base int) (int16, error) {
+	return toIntegerBase[int16](c, value, base)
+}
+
+// ToInt32Base converts any type of value to int32, parsing strings in the given base, ignoring errors.
+func ToInt32Base(value interface{}, base int) int32 {
+	return std.ToInt32Base(value, base)
+}
+
+// ToInt32Base is like the package-level ToInt32Base but uses the options of c.
+func (c *Converter) ToInt32Base(value interface{}, base int) int32 {
+	res, _ := c.ToInt32BaseE(value, base)
+	return res
+}
+
+// ToInt32BaseE converts any type of value to int32 or returns an error, like ToInt32E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToInt32BaseE(value interface{}, base int) (int32, error) {
+	return std.ToInt32BaseE(value, base)
+}
+
+// ToInt32BaseE is like the package-level ToInt32BaseE but uses the options of c.
+func (c *Converter) ToInt32BaseE(value interface{}, base int) (int32, error) {
+	return toIntegerBase[int32](c, value, base)
+}
+
+// ToInt64Base converts any type of value to int64, parsing strings in the given base, ignoring errors.
+func ToInt64Base(value interface{}, base int) int64 {
+	return std.ToInt64Base(value, base)
+}
+
+// ToInt64Base is like the package-level ToInt64Base but uses the options of c.
+func (c *Converter) ToInt64Base(value interface{}, base int) int64 {
+	res, _ := c.ToInt64BaseE(value, base)
+	return res
+}
+
+// ToInt64BaseE converts any type of value to int64 or returns an error, like ToInt64E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToInt64BaseE(value interface{}, base int) (int64, error) {
+	return std.ToInt64BaseE(value, base)
+}
+
+// ToInt64BaseE is like the package-level ToInt64BaseE but uses the options of c.
+func (c *Converter) ToInt64BaseE(value interface{}, base int) (int64, error) {
+	return toIntegerBase[int64](c, value, base)
+}
+
+// ToUintBase converts any type of value to uint, parsing strings in the given base, ignoring errors.
+func ToUintBase(value interface{}, base int) uint {
+	return std.ToUintBase(value, base)
+}
+
+// ToUintBase is like the package-level ToUintBase but uses the options of c.
+func (c *Converter) ToUintBase(value interface{}, base int) uint {
+	res, _ := c.ToUintBaseE(value, base)
+	return res
+}
+
+// ToUintBaseE converts any type of value to uint or returns an error, like ToUintE,
+// but parses strings in the given base. See ToIntBaseE.
+func ToUintBaseE(value interface{}, base int) (uint, error) {
+	return std.ToUintBaseE(value, base)
+}
+
+// ToUintBaseE is like the package-level ToUintBaseE but uses the options of c.
+func (c *Converter) ToUintBaseE(value interface{}, base int) (uint, error) {
+	return toIntegerBase[uint](c, value, base)
+}
+
+// ToUint8Base converts any type of value to uint8, parsing strings in the given base, ignoring errors.
+func ToUint8Base(value interface{}, base int) uint8 {
+	return std.ToUint8Base(value, base)
+}
+
+// ToUint8Base is like the package-level ToUint8Base but uses the options of c.
+func (c *Converter) ToUint8Base(value interface{}, base int) uint8 {
+	res, _ := c.ToUint8BaseE(value, base)
+	return res
+}
+
+// ToUint8BaseE converts any type of value to uint8 or returns an error, like ToUint8E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToUint8BaseE(value interface{}, base int) (uint8, error) {
+	return std.ToUint8BaseE(value, base)
+}
+
+// ToUint8BaseE is like the package-level ToUint8BaseE but uses the options of c.
+func (c *Converter) ToUint8BaseE(value interface{}, base int) (uint8, error) {
+	return toIntegerBase[uint8](c, value, base)
+}
+
+// ToUint16Base converts any type of value to uint16, parsing strings in the given base, ignoring errors.
+func ToUint16Base(value interface{}, base int) uint16 {
+	return std.ToUint16Base(value, base)
+}
+
+// ToUint16Base is like the package-level ToUint16Base but uses the options of c.
+func (c *Converter) ToUint16Base(value interface{}, base int) uint16 {
+	res, _ := c.ToUint16BaseE(value, base)
+	return res
+}
+
+// ToUint16BaseE converts any type of value to uint16 or returns an error, like ToUint16E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToUint16BaseE(value interface{}, base int) (uint16, error) {
+	return std.ToUint16BaseE(value, base)
+}
+
+// ToUint16BaseE is like the package-level ToUint16BaseE but uses the options of c.
+func (c *Converter) ToUint16BaseE(value interface{}, base int) (uint16, error) {
+	return toIntegerBase[uint16](c, value, base)
+}
+
+// ToUint32Base converts any type of value to uint32, parsing strings in the given base, ignoring errors.
+func ToUint32Base(value interface{}, base int) uint32 {
+	return std.ToUint32Base(value, base)
+}
+
+// ToUint32Base is like the package-level ToUint32Base but uses the options of c.
+func (c *Converter) ToUint32Base(value interface{}, base int) uint32 {
+	res, _ := c.ToUint32BaseE(value, base)
+	return res
+}
+
+// ToUint32BaseE converts any type of value to uint32 or returns an error, like ToUint32E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToUint32BaseE(value interface{}, base int) (uint32, error) {
+	return std.ToUint32BaseE(value, base)
+}
+
+// ToUint32BaseE is like the package-level ToUint32BaseE but uses the options of c.
+func (c *Converter) ToUint32BaseE(value interface{}, base int) (uint32, error) {
+	return toIntegerBase[uint32](c, value, base)
+}
+
+// ToUint64Base converts any type of value to uint64, parsing strings in the given base, ignoring errors.
+func ToUint64Base(value interface{}, base int) uint64 {
+	return std.ToUint64Base(value, base)
+}
+
+// ToUint64Base is like the package-level ToUint64Base but uses the options of c.
+func (c *Converter) ToUint64Base(value interface{}, base int) uint64 {
+	res, _ := c.ToUint64BaseE(value, base)
+	return res
+}
+
+// ToUint64BaseE converts any type of value to uint64 or returns an error, like ToUint64E,
+// but parses strings in the given base. See ToIntBaseE.
+func ToUint64BaseE(value interface{}, base int) (uint64, error) {
+	return std.ToUint64BaseE(value, base)
+}
+
+// ToUint64BaseE is like the package-level ToUint64BaseE but uses the options of c.
+func (c *Converter) ToUint64BaseE(value interface{}, base int) (uint64, error) {
+	return toIntegerBase[uint64](c, value, base)
+}
+
+// RadixFormat controls how ToStringBaseE writes integers.
+type RadixFormat struct {
+	// Prefix adds "0b", "0o" or "0x" in the bases 2, 8 and 16. Other bases have no prefix.
+	Prefix bool
+
+	// Width is the minimum number of digits, the number being padded with zeros.
+	Width int
+
+	// Upper writes the digits above 9 in upper case, like "FF".
+	Upper bool
+}
+
+// ToStringBase converts any type of value to a string of digits in the given base, ignoring errors.
+func ToStringBase(value interface{}, base int, format ...RadixFormat) string {
+	return std.ToStringBase(value, base, format...)
+}
+
+// ToStringBase is like the package-level ToStringBase but uses the options of c.
+func (c *Converter) ToStringBase(value interface{}, base int, format ...RadixFormat) string {
+	res, _ := c.ToStringBaseE(value, base, format...)
+	return res
+}
+
+// ToStringBaseE converts any type of value to an integer, as ToBigIntE does, and writes it
+// in the given base, between 2 and 36, or returns an error. Negative numbers start with "-",
+// before the prefix. An optional RadixFormat sets the prefix, the padding and the case of the digits.
+// Other bases are reported as ErrUnsupported.
+//
+// Example:
+//
+//	s, err := ToStringBaseE(255, 16, RadixFormat{Prefix: true, Width: 4})
+//	fmt.Println(s) // Output: 0x00ff
+func ToStringBaseE(value interface{}, base int, format ...RadixFormat) (string, error) {
+	return std.ToStringBaseE(value, base, format...)
+}
+
+// ToStringBaseE is like the package-level ToStringBaseE but uses the options of c.
+func (c *Converter) ToStringBaseE(value interface{}, base int, format ...RadixFormat) (string, error) {
+	if base < 2 || base > 36 {
+		return "", baseError(value, reflect.TypeFor[string](), base)
+	}
+
+	n, err := c.ToBigIntE(value)
+	if err != nil {
+		return "", err
+	}
+
+	var f RadixFormat
+	if len(format) > 0 {
+		f = format[0]
+	}
+
+	digits := new(big.Int).Abs(n).Text(base)
+	if f.Upper {
+		digits = strings.ToUpper(digits)
+	}
+	if pad := f.Width - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	if f.Prefix {
+		digits = radixPrefixes[base] + digits
+	}
+	if n.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return digits, nil
+}
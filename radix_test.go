@@ -0,0 +1,128 @@
+package convert
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithIntegerBase(t *testing.T) {
+	assert.Equal(t, 8, ToInt("010"))
+	assert.Equal(t, 31, ToInt("0x1f"))
+
+	dec := New(WithIntegerBase(10))
+	assert.Equal(t, 10, dec.ToInt("010"))
+	assert.Equal(t, uint16(7), dec.ToUint16("0007"))
+	assert.Equal(t, int64(-42), dec.ToInt64("-042"))
+	assert.Equal(t, "10", dec.ToBigInt("010").String())
+	assert.Equal(t, "10", dec.ToBigFloat("010").String())
+	assert.Equal(t, "10/1", dec.ToBigRat("010").String())
+	assert.Equal(t, "1.5", dec.ToBigFloat("1.5").String())
+	_, err := dec.ToIntE("0x1f")
+	assert.ErrorIs(t, err, ErrSyntax)
+	_, err = dec.With(WithStrict(true)).ToIntE("010")
+	assert.NoError(t, err)
+
+	hex := New(WithIntegerBase(16))
+	assert.Equal(t, 255, hex.ToInt("ff"))
+	assert.Equal(t, 255, hex.ToInt("0xFF"))
+	assert.Equal(t, -16, hex.ToInt("-0x10"))
+	assert.Equal(t, "255", hex.ToBigFloat("ff").String())
+	_, err = hex.ToBigIntE("1.5")
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	bin := New(WithIntegerBase(2))
+	_, err = bin.ToInt64E("12")
+	assert.ErrorIs(t, err, ErrSyntax)
+	_, err = bin.With(WithStrict(true)).ToInt64E("12")
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	assert.Equal(t, 8, New(WithIntegerBase(99)).ToInt("010"))
+}
+
+func TestToIntBaseE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		base     int
+		expected interface{}
+		err      error
+		convert  func(value interface{}, base int) (interface{}, error)
+	}{
+		{"ff", 16, 255, nil, func(v interface{}, b int) (interface{}, error) { return ToIntBaseE(v, b) }},
+		{"010", 10, int8(10), nil, func(v interface{}, b int) (interface{}, error) { return ToInt8BaseE(v, b) }},
+		{"-101", 2, int16(-5), nil, func(v interface{}, b int) (interface{}, error) { return ToInt16BaseE(v, b) }},
+		{"0o777", 8, int32(511), nil, func(v interface{}, b int) (interface{}, error) { return ToInt32BaseE(v, b) }},
+		{"zz", 36, int64(1295), nil, func(v interface{}, b int) (interface{}, error) { return ToInt64BaseE(v, b) }},
+		{"7fffffffffffffff", 16, uint(math.MaxInt64), nil, func(v interface{}, b int) (interface{}, error) { return ToUintBaseE(v, b) }},
+		{"100", 16, uint8(0), ErrOverflow, func(v interface{}, b int) (interface{}, error) { return ToUint8BaseE(v, b) }},
+		{"0b1111", 2, uint16(15), nil, func(v interface{}, b int) (interface{}, error) { return ToUint16BaseE(v, b) }},
+		{"g", 16, uint32(0), ErrSyntax, func(v interface{}, b int) (interface{}, error) { return ToUint32BaseE(v, b) }},
+		{"ffffffffffffffff", 16, uint64(math.MaxUint64), nil, func(v interface{}, b int) (interface{}, error) { return ToUint64BaseE(v, b) }},
+		{42, 16, 42, nil, func(v interface{}, b int) (interface{}, error) { return ToIntBaseE(v, b) }},
+		{"12", 2, 0, ErrSyntax, func(v interface{}, b int) (interface{}, error) { return ToIntBaseE(v, b) }},
+		{"9", 8, int8(0), ErrSyntax, func(v interface{}, b int) (interface{}, error) { return ToInt8BaseE(v, b) }},
+		{"1.5", 2, int16(0), ErrSyntax, func(v interface{}, b int) (interface{}, error) { return ToInt16BaseE(v, b) }},
+		{"true", 10, uint(0), ErrSyntax, func(v interface{}, b int) (interface{}, error) { return ToUintBaseE(v, b) }},
+		{"fg", 16, uint64(0), ErrSyntax, func(v interface{}, b int) (interface{}, error) { return ToUint64BaseE(v, b) }},
+		{"1" + strings.Repeat("0", 64), 2, uint64(0), ErrOverflow, func(v interface{}, b int) (interface{}, error) { return ToUint64BaseE(v, b) }},
+		{"10", 1, 0, ErrUnsupported, func(v interface{}, b int) (interface{}, error) { return ToIntBaseE(v, b) }},
+		{"10", 37, uint64(0), ErrUnsupported, func(v interface{}, b int) (interface{}, error) { return ToUint64BaseE(v, b) }},
+	}
+
+	for _, test := range tests {
+		res, err := test.convert(test.input, test.base)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %v base %d", test.input, test.base)
+			continue
+		}
+		if assert.NoError(t, err, "input %v base %d", test.input, test.base) {
+			assert.Equal(t, test.expected, res, "input %v base %d", test.input, test.base)
+		}
+	}
+
+	assert.Equal(t, 10, ToIntBase("010", 10))
+	assert.Equal(t, uint8(255), New(WithStrict(true)).ToUint8Base("FF", 16))
+}
+
+func TestToStringBaseE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		base     int
+		format   []RadixFormat
+		expected string
+		err      error
+	}{
+		{255, 16, nil, "ff", nil},
+		{255, 16, []RadixFormat{{Prefix: true, Width: 4}}, "0x00ff", nil},
+		{255, 16, []RadixFormat{{Prefix: true, Upper: true}}, "0xFF", nil},
+		{-5, 2, []RadixFormat{{Prefix: true, Width: 8}}, "-0b00000101", nil},
+		{8, 8, []RadixFormat{{Prefix: true}}, "0o10", nil},
+		{1295, 36, []RadixFormat{{Prefix: true, Upper: true}}, "ZZ", nil},
+		{uint64(math.MaxUint64), 16, nil, "ffffffffffffffff", nil},
+		{new(big.Int).Lsh(big.NewInt(1), 100), 32, nil, "100000000000000000000", nil},
+		{"0x1f", 10, nil, "31", nil},
+		{12345, 10, []RadixFormat{{Width: 8}}, "00012345", nil},
+		{"abc", 16, nil, "", ErrSyntax},
+		{10, 1, nil, "", ErrUnsupported},
+	}
+
+	for _, test := range tests {
+		res, err := ToStringBaseE(test.input, test.base, test.format...)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, "input %v base %d", test.input, test.base)
+			continue
+		}
+		if assert.NoError(t, err, "input %v base %d", test.input, test.base) {
+			assert.Equal(t, test.expected, res, "input %v base %d", test.input, test.base)
+		}
+	}
+
+	assert.Equal(t, "11", ToStringBase(3, 2))
+	for base := 2; base <= 36; base++ {
+		s := ToStringBase(-123456789, base, RadixFormat{Prefix: true})
+		assert.Equal(t, -123456789, ToIntBase(s, base), "base %d", base)
+	}
+}
@@ -0,0 +1,218 @@
+package convert
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ratioSymbols are the symbols of the percentages parsed with WithFractions, and their scale.
+var ratioSymbols = []struct {
+	symbol string
+	scale  int64
+}{
+	{"%", 100},
+	{"‰", 1000}, // per mille
+}
+
+// WithFractions makes the integer, float, big number and Decimal converters parse
+// percentages, per-mille values and fractions in strings: "45%" gives 0.45, "5‰" gives 0.005,
+// "3/4" gives 0.75 and the mixed fraction "1 1/2" gives 1.5. The symbol may precede or follow
+// the number, and the number is read in the format of WithNumberFormat when set, like "12,5 %".
+// Fractions are made of decimal digits, and a zero denominator is reported as ErrDivisionByZero.
+//
+// The values are exact: *big.Rat and *big.Int results hold them without loss, floats are
+// rounded to the nearest value and integers with the rounding mode of the converter.
+// It is disabled by default; ToRatioE always parses them.
+//
+// Example:
+//
+//	sheet := convert.New(convert.WithFractions(true))
+//	rate, err := sheet.ToFloat64E("45%") // 0.45
+func WithFractions(enabled bool) Option {
+	return func(o *options) {
+		o.fractions = enabled
+	}
+}
+
+// ToRatio converts any type of value to an exact *big.Rat, ignoring errors.
+func ToRatio(value interface{}) *big.Rat {
+	return std.ToRatio(value)
+}
+
+// ToRatio is like the package-level ToRatio but uses the options of c.
+func (c *Converter) ToRatio(value interface{}) *big.Rat {
+	res, _ := c.ToRatioE(value)
+	return res
+}
+
+// ToRatioE converts any type of value to an exact *big.Rat or returns an error.
+// It is like ToBigRatE with WithFractions: strings may be percentages, per-mille values
+// or simple and mixed fractions, like "45%", "5‰", "3/4" or "-1 1/2".
+//
+// Example:
+//
+//	r, err := convert.ToRatioE("1 1/2")
+//	fmt.Println(r) // Output: 3/2
+func ToRatioE(value interface{}) (*big.Rat, error) {
+	return std.ToRatioE(value)
+}
+
+// ToRatioE is like the package-level ToRatioE but uses the options of c.
+func (c *Converter) ToRatioE(value interface{}) (*big.Rat, error) {
+	return c.With(WithFractions(true)).ToBigRatE(value)
+}
+
+// ToPercentString formats any type of value as a percentage, ignoring errors.
+func ToPercentString(value interface{}, precision int) string {
+	return std.ToPercentString(value, precision)
+}
+
+// ToPercentString is like the package-level ToPercentString but uses the options of c.
+func (c *Converter) ToPercentString(value interface{}, precision int) string {
+	res, _ := c.ToPercentStringE(value, precision)
+	return res
+}
+
+// ToPercentStringE converts value like ToRatioE does and formats it as a percentage,
+// like "45%" for 0.45 or "33.3%" for "1/3" with a precision of 1. The precision is the
+// number of digits after the point, rounded half to even; -1 uses the fewest digits
+// that represent the percentage as a float64. The grouping and decimal separators of
+// WithNumberGrouping and WithFormatLocale are applied.
+//
+// Example:
+//
+//	s, err := convert.ToPercentStringE("3/8", 1)
+//	fmt.Println(s) // Output: 37.5%
+func ToPercentStringE(value interface{}, precision int) (string, error) {
+	return std.ToPercentStringE(value, precision)
+}
+
+// ToPercentStringE is like the package-level ToPercentStringE but uses the options of c.
+func (c *Converter) ToPercentStringE(value interface{}, precision int) (string, error) {
+	r, err := c.ToRatioE(value)
+	if err != nil {
+		return "", err
+	}
+	r.Mul(r, big.NewRat(100, 1))
+
+	var s string
+	if precision < 0 {
+		f, _ := r.Float64()
+		s = strconv.FormatFloat(f, 'f', -1, 64)
+	} else {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+		n, _ := quoRound(new(big.Int).Mul(r.Num(), scale), r.Denom(), RoundHalfEven)
+		s = new(big.Rat).SetFrac(n, scale).FloatString(precision)
+	}
+
+	style := c.opts.numberStyle
+	style.width = 0
+	return style.apply(s) + "%", nil
+}
+
+// parseRatio parses s as a percentage, a per-mille value or a fraction. It reports false
+// when s is none of them, and ErrSyntax or ErrDivisionByZero when s is malformed.
+func (c *Converter) parseRatio(s string) (*big.Rat, bool, error) {
+	body := strings.TrimSpace(s)
+	scale := int64(1)
+	for _, sym := range ratioSymbols {
+		if trimmed, ok := strings.CutSuffix(body, sym.symbol); ok {
+			body, scale = trimmed, sym.scale
+			break
+		}
+		if trimmed, ok := strings.CutPrefix(body, sym.symbol); ok {
+			body, scale = trimmed, sym.scale
+			break
+		}
+	}
+	if scale == 1 && !strings.Contains(body, "/") {
+		return nil, false, nil
+	}
+
+	body = strings.TrimSpace(body)
+	neg := strings.HasPrefix(body, "-")
+	if neg || strings.HasPrefix(body, "+") {
+		body = strings.TrimSpace(body[1:])
+	}
+	if body == "" || !isDigit(body[0]) && body[0] != '.' {
+		return nil, false, ErrSyntax
+	}
+
+	var r *big.Rat
+	if strings.Contains(body, "/") {
+		var err error
+		if r, err = parseFraction(body); err != nil {
+			return nil, false, err
+		}
+	} else {
+		if f := c.opts.numberFormat; f != nil {
+			lit, ok, err := f.normalize(body)
+			if err != nil {
+				return nil, false, err
+			}
+			if !ok {
+				return nil, false, ErrSyntax
+			}
+			body = lit
+		}
+		var ok bool
+		if r, ok = new(big.Rat).SetString(body); !ok {
+			return nil, false, ErrSyntax
+		}
+	}
+
+	if neg {
+		r.Neg(r)
+	}
+	return r.Quo(r, big.NewRat(scale, 1)), true, nil
+}
+
+// parseFraction parses a simple fraction like "3/4" or a mixed one like "1 1/2", without sign.
+func parseFraction(s string) (*big.Rat, error) {
+	fields := strings.Fields(s)
+	whole := "0"
+	switch len(fields) {
+	case 1:
+	case 2:
+		whole = fields[0]
+	default:
+		return nil, ErrSyntax
+	}
+	num, den, _ := strings.Cut(fields[len(fields)-1], "/")
+	if whole == "" || num == "" || den == "" || !isDigits(whole) || !isDigits(num) || !isDigits(den) {
+		return nil, ErrSyntax
+	}
+
+	d, _ := new(big.Int).SetString(den, 10)
+	if d.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	n, _ := new(big.Int).SetString(num, 10)
+	w, _ := new(big.Int).SetString(whole, 10)
+	r := new(big.Rat).SetFrac(n, d)
+	return r.Add(r, new(big.Rat).SetInt(w)), nil
+}
+
+// ratioValue returns r as the value converted to to by the number converters:
+// the nearest float for float types, and the big.Rat itself for the others.
+func ratioValue(value interface{}, r *big.Rat, to reflect.Type) (interface{}, error) {
+	switch to.Kind() {
+	case reflect.Float32:
+		f, _ := r.Float32()
+		if math.IsInf(float64(f), 0) {
+			return nil, newError(value, to, ErrOverflow)
+		}
+		return f, nil
+	case reflect.Float64:
+		f, _ := r.Float64()
+		if math.IsInf(f, 0) {
+			return nil, newError(value, to, ErrOverflow)
+		}
+		return f, nil
+	default:
+		return *r, nil
+	}
+}
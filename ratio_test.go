@@ -0,0 +1,150 @@
+package convert
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithFractions(t *testing.T) {
+	c := New(WithFractions(true))
+	tests := []struct {
+		input    string
+		expected float64
+		err      error
+	}{
+		{"45%", 0.45, nil},
+		{"12.5 %", 0.125, nil},
+		{"-3%", -0.03, nil},
+		{"5‰", 0.005, nil},
+		{"‰5", 0.005, nil},
+		{"3/4", 0.75, nil},
+		{"1 1/2", 1.5, nil},
+		{"-1 1/2", -1.5, nil},
+		{"1/3", 1.0 / 3, nil},
+		{"1.5", 1.5, nil},
+		{"1/0", 0, ErrDivisionByZero},
+		{"%", 0, ErrSyntax},
+		{"--5%", 0, ErrSyntax},
+		{"1 2 3/4", 0, ErrSyntax},
+		{"1.5/2", 0, ErrSyntax},
+		{"abc%", 0, ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			res, err := c.ToFloat64E(tt.input)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestWithFractionsTargets(t *testing.T) {
+	c := New(WithFractions(true))
+
+	r, err := c.ToBigRatE("1/3")
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewRat(1, 3), r)
+
+	f, err := c.ToFloat32E("45%")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.45), f)
+
+	n, err := c.ToIntE("250%")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = c.With(WithRounding(RoundHalfUp)).ToIntE("2 1/2")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	_, err = c.ToUint8E("30000%")
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	d, err := c.ToDecimalE("12.5%")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.125", d.String())
+
+	_, err = c.ToDecimalE("1/3")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = c.With(WithStrict(true)).ToIntE("3/4")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	german := c.With(WithNumberFormat(NumberFormatGerman))
+	res, err := german.ToFloat64E("12,5 %")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.125, res)
+	res, err = german.ToFloat64E("1.234,5")
+	assert.NoError(t, err)
+	assert.Equal(t, 1234.5, res)
+}
+
+func TestFractionsDisabled(t *testing.T) {
+	_, err := ToFloat64E("45%")
+	assert.ErrorIs(t, err, ErrSyntax)
+	_, err = ToFloat64E("3/4")
+	assert.ErrorIs(t, err, ErrSyntax)
+	_, err = New(WithFractions(false)).ToIntE("1 1/2")
+	assert.ErrorIs(t, err, ErrSyntax)
+}
+
+func TestToRatioE(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected *big.Rat
+	}{
+		{"1 1/2", big.NewRat(3, 2)},
+		{"45%", big.NewRat(9, 20)},
+		{"5‰", big.NewRat(1, 200)},
+		{"0.25", big.NewRat(1, 4)},
+		{3, big.NewRat(3, 1)},
+		{0.5, big.NewRat(1, 2)},
+	}
+
+	for _, tt := range tests {
+		res, err := ToRatioE(tt.input)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, res)
+	}
+
+	assert.Equal(t, big.NewRat(2, 3), ToRatio("2/3"))
+	assert.Nil(t, ToRatio("2/0"))
+}
+
+func TestToPercentStringE(t *testing.T) {
+	tests := []struct {
+		input     interface{}
+		precision int
+		expected  string
+	}{
+		{0.45, -1, "45%"},
+		{0.125, -1, "12.5%"},
+		{"1/3", 1, "33.3%"},
+		{"2/3", 0, "67%"},
+		{"3/8", 1, "37.5%"},
+		{"0.125%", 2, "0.12%"},
+		{-1.5, 0, "-150%"},
+		{"45%", -1, "45%"},
+		{1, 2, "100.00%"},
+	}
+
+	for _, tt := range tests {
+		res, err := ToPercentStringE(tt.input, tt.precision)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, res)
+	}
+
+	_, err := ToPercentStringE("abc", 1)
+	assert.ErrorIs(t, err, ErrSyntax)
+	assert.Equal(t, "", ToPercentString("1/0", 1))
+
+	de := New(WithFormatLocale(NumberFormatGerman), WithNumberWidth(10, true))
+	assert.Equal(t, "1.234,50%", de.ToPercentString(12.345, 2))
+}
@@ -2,7 +2,6 @@ package convert
 
 import (
 	"encoding/json"
-	"errors"
 	"reflect"
 	"time"
 	"unicode"
@@ -18,78 +17,226 @@ type CasterE func(value interface{}) (reflect.Value, error)
 type CasterConvert func(value interface{}) reflect.Value
 
 var (
-	stringType   = reflect.TypeOf("")
-	boolType     = reflect.TypeOf(true)
-	intType      = reflect.TypeOf(int(0))
-	int8Type     = reflect.TypeOf(int8(0))
-	int16Type    = reflect.TypeOf(int16(0))
-	int32Type    = reflect.TypeOf(int32(0))
-	int64Type    = reflect.TypeOf(int64(0))
-	uintType     = reflect.TypeOf(uint(0))
-	uint8Type    = reflect.TypeOf(uint8(0))
-	uint16Type   = reflect.TypeOf(uint16(0))
-	uint32Type   = reflect.TypeOf(uint32(0))
-	uint64Type   = reflect.TypeOf(uint64(0))
-	float32Type  = reflect.TypeOf(float32(0))
-	float64Type  = reflect.TypeOf(float64(0))
-	timeType     = reflect.TypeOf((*time.Time)(nil)).Elem()
-	durationType = reflect.TypeOf(time.Duration(0))
-	nilType      = reflect.TypeOf(nil)
-	InvalidType  = reflect.TypeOf(reflect.Value{})
+	stringType     = reflect.TypeOf("")
+	boolType       = reflect.TypeOf(true)
+	intType        = reflect.TypeOf(int(0))
+	int8Type       = reflect.TypeOf(int8(0))
+	int16Type      = reflect.TypeOf(int16(0))
+	int32Type      = reflect.TypeOf(int32(0))
+	int64Type      = reflect.TypeOf(int64(0))
+	uintType       = reflect.TypeOf(uint(0))
+	uint8Type      = reflect.TypeOf(uint8(0))
+	uint16Type     = reflect.TypeOf(uint16(0))
+	uint32Type     = reflect.TypeOf(uint32(0))
+	uint64Type     = reflect.TypeOf(uint64(0))
+	float32Type    = reflect.TypeOf(float32(0))
+	float64Type    = reflect.TypeOf(float64(0))
+	complex64Type  = reflect.TypeOf(complex64(0))
+	complex128Type = reflect.TypeOf(complex128(0))
+	timeType       = reflect.TypeOf((*time.Time)(nil)).Elem()
+	durationType   = reflect.TypeOf(time.Duration(0))
+	nilType        = reflect.TypeOf(nil)
+	InvalidType    = reflect.TypeOf(reflect.Value{})
 
 	InvalidValue = reflect.Value{}
 )
 
-var casters = map[reflect.Type]CasterE{
-	stringType:   castStringE,
-	boolType:     castBoolE,
-	intType:      castIntE,
-	int8Type:     castInt8E,
-	int16Type:    castInt16E,
-	int32Type:    castInt32E,
-	int64Type:    castInt64E,
-	uintType:     castUintE,
-	uint8Type:    castUint8E,
-	uint16Type:   castUint16E,
-	uint32Type:   castUint32E,
-	uint64Type:   castUint64E,
-	float32Type:  castFloat32E,
-	float64Type:  castFloat64E,
-	timeType:     castTimeE,
-	durationType: castTimeDurationE,
+// basicTypes maps the basic kinds to their predeclared types.
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:       boolType,
+	reflect.Int:        intType,
+	reflect.Int8:       int8Type,
+	reflect.Int16:      int16Type,
+	reflect.Int32:      int32Type,
+	reflect.Int64:      int64Type,
+	reflect.Uint:       uintType,
+	reflect.Uint8:      uint8Type,
+	reflect.Uint16:     uint16Type,
+	reflect.Uint32:     uint32Type,
+	reflect.Uint64:     uint64Type,
+	reflect.Uintptr:    reflect.TypeOf(uintptr(0)),
+	reflect.Float32:    float32Type,
+	reflect.Float64:    float64Type,
+	reflect.Complex64:  complex64Type,
+	reflect.Complex128: complex128Type,
+	reflect.String:     stringType,
+}
+
+// basicValue returns a value of a defined type with a basic underlying type,
+// like `type Port uint16`, converted to that basic type. Other values are returned unchanged.
+func basicValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	bt, ok := basicTypes[rv.Kind()]
+	if !ok || rv.Type() == bt {
+		return value
+	}
+	return rv.Convert(bt).Interface()
+}
+
+// isDefinedBasic reports whether t is a defined type with a basic underlying type, like `type Port uint16`.
+func isDefinedBasic(t reflect.Type) bool {
+	bt, ok := basicTypes[t.Kind()]
+	return ok && t != bt
+}
+
+var casters = map[reflect.Type]func(c *Converter, value interface{}) (reflect.Value, error){
+	stringType:     (*Converter).castStringE,
+	boolType:       (*Converter).castBoolE,
+	intType:        (*Converter).castIntE,
+	int8Type:       (*Converter).castInt8E,
+	int16Type:      (*Converter).castInt16E,
+	int32Type:      (*Converter).castInt32E,
+	int64Type:      (*Converter).castInt64E,
+	uintType:       (*Converter).castUintE,
+	uint8Type:      (*Converter).castUint8E,
+	uint16Type:     (*Converter).castUint16E,
+	uint32Type:     (*Converter).castUint32E,
+	uint64Type:     (*Converter).castUint64E,
+	float32Type:    (*Converter).castFloat32E,
+	float64Type:    (*Converter).castFloat64E,
+	complex64Type:  (*Converter).castComplex64E,
+	complex128Type: (*Converter).castComplex128E,
+	timeType:       (*Converter).castTimeE,
+	durationType:   (*Converter).castTimeDurationE,
+	bigIntType:     (*Converter).castBigIntE,
+	bigFloatType:   (*Converter).castBigFloatE,
+	bigRatType:     (*Converter).castBigRatE,
+	decimalType:    (*Converter).castDecimalE,
+	byteSizeType:   (*Converter).castByteSizeE,
 }
 
 // ToValue converts a value to a specified type using custom casters.
 func ToValue(value interface{}, to reflect.Type, converters ...CasterConvert) reflect.Value {
-	res, _ := ToValueE(value, to, converters...)
+	return std.ToValue(value, to, converters...)
+}
+
+// ToValue is like the package-level ToValue but uses the options of c.
+func (c *Converter) ToValue(value interface{}, to reflect.Type, converters ...CasterConvert) reflect.Value {
+	res, _ := c.ToValueE(value, to, converters...)
 	return res
 }
 
 // ToValueE converts a value to a specified type using custom casters with error.
+// Scalar types use the casters table. Defined types with a basic underlying type,
+// like `type Port uint16`, use the caster of that type, with its range checks.
+// Types implementing encoding.TextUnmarshaler, like netip.Addr or big.Int, are filled
+// from the string form of the value; for defined basic types, only string and []byte
+// values go through UnmarshalText.
+// Types implementing sql.Scanner, like sql.NullString, are filled with Scan from
+// the driver value of the value, as returned by ToDriverValueE.
+// Optional types are filled as ToOptionalE does: nil values give an explicit null.
+// Containers are converted recursively, their leaves being converted with the casters:
+//   - slices and arrays from slices and arrays, element by element
+//   - maps from maps, key by key and value by value
+//   - pointers by converting to the element type
+//   - structs from maps, as Decode does
+//   - interfaces from values implementing them
+//
+// Example:
+//
+//	v, err := ToValueE([]interface{}{"1", 2}, reflect.TypeOf([]int8{}))
+//	fmt.Println(v.Interface()) // Output: [1 2]
 func ToValueE(value interface{}, to reflect.Type, converters ...CasterConvert) (reflect.Value, error) {
+	return std.ToValueE(value, to, converters...)
+}
+
+// ToValueE is like the package-level ToValueE but uses the options of c.
+func (c *Converter) ToValueE(value interface{}, to reflect.Type, converters ...CasterConvert) (reflect.Value, error) {
 	for _, converter := range converters {
 		if result := converter(value); result.IsValid() {
 			return result, nil
 		}
 	}
 
+	if res, ok, err := lookupRegistry(value, to); ok {
+		if err != nil {
+			return InvalidValue, err
+		}
+		return registryValue(res, to), nil
+	}
+
 	v := Indirect(value)
 
 	if caster, ok := casters[to]; ok {
-		return caster(v)
+		return caster(c, v)
+	}
+
+	if value != nil && reflect.TypeOf(value).AssignableTo(to) {
+		res := reflect.New(to).Elem()
+		res.Set(reflect.ValueOf(value))
+		return res, nil
+	}
+
+	if isOptional(to) {
+		return c.toOptional(value, to)
+	}
+
+	if isScanner(to) {
+		return c.scan(value, to)
 	}
 
-	return InvalidValue, errors.New("casting not supported")
+	if isTextUnmarshaler(to) {
+		switch v.(type) {
+		case string, []byte:
+			return c.unmarshalText(v, to)
+		}
+		if !isDefinedBasic(to) {
+			return c.unmarshalText(value, to)
+		}
+	}
+
+	if isDefinedBasic(to) {
+		if caster, ok := casters[basicTypes[to.Kind()]]; ok {
+			res, err := caster(c, v)
+			if err != nil {
+				return InvalidValue, err
+			}
+			return res.Convert(to), nil
+		}
+	}
+
+	switch to.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Pointer, reflect.Struct, reflect.Interface:
+		res := reflect.New(to).Elem()
+		if err := c.decode(value, res); err != nil {
+			return InvalidValue, err
+		}
+		return res, nil
+	}
+
+	return InvalidValue, unsupportedError(value, to)
+}
+
+// registryValue wraps the result of a registered conversion function into a value of the given type.
+func registryValue(res interface{}, to reflect.Type) reflect.Value {
+	v := reflect.New(to).Elem()
+	if res != nil {
+		v.Set(reflect.ValueOf(res))
+	}
+	return v
 }
 
 // ToJsonValue converts a value to a specified type using JSON unmarshalling.
 func ToJsonValue(value interface{}, to reflect.Type, converters ...CasterConvert) reflect.Value {
-	res, _ := ToJsonValueE(value, to, converters...)
+	return std.ToJsonValue(value, to, converters...)
+}
+
+// ToJsonValue is like the package-level ToJsonValue but uses the options of c.
+func (c *Converter) ToJsonValue(value interface{}, to reflect.Type, converters ...CasterConvert) reflect.Value {
+	res, _ := c.ToJsonValueE(value, to, converters...)
 	return res
 }
 
 // ToJsonValueE converts a value to a specified type using JSON unmarshalling with error.
 func ToJsonValueE(value interface{}, to reflect.Type, converters ...CasterConvert) (reflect.Value, error) {
+	return std.ToJsonValueE(value, to, converters...)
+}
+
+// ToJsonValueE is like the package-level ToJsonValueE but uses the options of c.
+func (c *Converter) ToJsonValueE(value interface{}, to reflect.Type, converters ...CasterConvert) (reflect.Value, error) {
 	for _, converter := range converters {
 		if result := converter(value); result.IsValid() {
 			return result, nil
@@ -98,7 +245,7 @@ func ToJsonValueE(value interface{}, to reflect.Type, converters ...CasterConver
 
 	v := Indirect(value)
 
-	jsonString, err := ToStringE(v)
+	jsonString, err := c.plainString(v)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -119,14 +266,14 @@ func ToJsonValueE(value interface{}, to reflect.Type, converters ...CasterConver
 //	return res
 // }
 
-func castStringE(value interface{}) (reflect.Value, error) {
+func (c *Converter) castStringE(value interface{}) (reflect.Value, error) {
 	var s string
 	var err error
 
 	if reflect.TypeOf(value) == timeType {
 		s = value.(time.Time).Format(time.RFC3339)
 	} else {
-		s, err = ToStringE(value)
+		s, err = c.ToStringE(value)
 		if err != nil {
 			return InvalidValue, err
 		}
@@ -140,8 +287,8 @@ func castStringE(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castBoolE(value interface{}) (reflect.Value, error) {
-	v, err := ToBoolE(value)
+func (c *Converter) castBoolE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToBoolE(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -154,8 +301,8 @@ func castBoolE(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castIntE(value interface{}) (reflect.Value, error) {
-	v, err := ToIntE(value)
+func (c *Converter) castIntE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToIntE(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -168,8 +315,8 @@ func castIntE(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castInt8E(value interface{}) (reflect.Value, error) {
-	v, err := ToInt8E(value)
+func (c *Converter) castInt8E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToInt8E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -182,8 +329,8 @@ func castInt8E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castInt16E(value interface{}) (reflect.Value, error) {
-	v, err := ToInt16E(value)
+func (c *Converter) castInt16E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToInt16E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -196,8 +343,8 @@ func castInt16E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castInt32E(value interface{}) (reflect.Value, error) {
-	v, err := ToInt32E(value)
+func (c *Converter) castInt32E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToInt32E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -210,8 +357,8 @@ func castInt32E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castInt64E(value interface{}) (reflect.Value, error) {
-	v, err := ToInt64E(value)
+func (c *Converter) castInt64E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToInt64E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -224,8 +371,8 @@ func castInt64E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castUintE(value interface{}) (reflect.Value, error) {
-	v, err := ToUintE(value)
+func (c *Converter) castUintE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToUintE(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -238,8 +385,8 @@ func castUintE(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castUint8E(value interface{}) (reflect.Value, error) {
-	v, err := ToUint8E(value)
+func (c *Converter) castUint8E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToUint8E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -252,8 +399,8 @@ func castUint8E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castUint16E(value interface{}) (reflect.Value, error) {
-	v, err := ToUint16E(value)
+func (c *Converter) castUint16E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToUint16E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -266,8 +413,8 @@ func castUint16E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castUint32E(value interface{}) (reflect.Value, error) {
-	v, err := ToUint32E(value)
+func (c *Converter) castUint32E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToUint32E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -280,8 +427,8 @@ func castUint32E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castUint64E(value interface{}) (reflect.Value, error) {
-	v, err := ToUint64E(value)
+func (c *Converter) castUint64E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToUint64E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -294,8 +441,8 @@ func castUint64E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castFloat32E(value interface{}) (reflect.Value, error) {
-	v, err := ToFloat32E(value)
+func (c *Converter) castFloat32E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToFloat32E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -308,8 +455,26 @@ func castFloat32E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castFloat64E(value interface{}) (reflect.Value, error) {
-	v, err := ToFloat64E(value)
+func (c *Converter) castFloat64E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToFloat64E(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castComplex64E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToComplex64E(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castComplex128E(value interface{}) (reflect.Value, error) {
+	v, err := c.ToComplex128E(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -322,8 +487,8 @@ func castFloat64E(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castTimeE(value interface{}) (reflect.Value, error) {
-	v, err := ToTimeE(value)
+func (c *Converter) castTimeE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToTimeE(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -336,8 +501,53 @@ func castTimeE(value interface{}) (reflect.Value, error) {
 //	return res
 // }
 
-func castTimeDurationE(value interface{}) (reflect.Value, error) {
-	v, err := ToDurationE(value)
+func (c *Converter) castTimeDurationE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToDurationE(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castBigIntE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToBigIntE(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castBigFloatE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToBigFloatE(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castBigRatE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToBigRatE(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castDecimalE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToDecimalE(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+func (c *Converter) castByteSizeE(value interface{}) (reflect.Value, error) {
+	v, err := c.ToByteSizeE(value)
 	if err != nil {
 		return InvalidValue, err
 	}
@@ -364,6 +574,11 @@ func IsAlphanumeric(value interface{}) bool {
 
 // GetConvertType returns the reflect.Type of a value based on its conversion.
 func GetConvertType(value interface{}) reflect.Type {
+	return std.GetConvertType(value)
+}
+
+// GetConvertType is like the package-level GetConvertType but uses the options of c.
+func (c *Converter) GetConvertType(value interface{}) reflect.Type {
 	if value == nil {
 		return nilType
 	}
@@ -386,7 +601,7 @@ func GetConvertType(value interface{}) reflect.Type {
 		return float32Type
 	case reflect.Float64:
 		// For float64, check if it's actually an integer
-		f := value.(float64)
+		f := reflect.ValueOf(value).Float()
 		if f == float64(int(f)) {
 			return int64Type
 		}
@@ -395,7 +610,7 @@ func GetConvertType(value interface{}) reflect.Type {
 		return boolType
 	case reflect.String:
 		// Pour les chaînes vides, retourner directement stringType
-		if v := value.(string); v == "" {
+		if v := reflect.ValueOf(value).String(); v == "" {
 			return stringType
 		}
 	}
@@ -404,22 +619,22 @@ func GetConvertType(value interface{}) reflect.Type {
 	// try to find the type
 
 	// Check special types first
-	if _, err := ToTimeE(value); err == nil {
+	if _, err := c.ToTimeE(value); err == nil {
 		return timeType
 	}
-	if _, err := ToDurationE(value); err == nil {
+	if _, err := c.ToDurationE(value); err == nil {
 		return durationType
 	}
 	if IsAlphanumeric(value) {
-		if _, err := ToBoolE(value); err == nil {
+		if _, err := c.ToBoolE(value); err == nil {
 			return boolType
 		}
 	}
 
 	// Then check numeric types
-	if intVal, err := ToIntE(value); err == nil {
+	if intVal, err := c.ToIntE(value); err == nil {
 		// Check if it's a string or another type that could be a float
-		if floatVal, err := ToFloat64E(value); err == nil {
+		if floatVal, err := c.ToFloat64E(value); err == nil {
 			// If the float value is equal to its integer part, it's an integer
 			if float64(intVal) == floatVal {
 				return int64Type
@@ -431,20 +646,20 @@ func GetConvertType(value interface{}) reflect.Type {
 	}
 
 	// Check if it's an unsigned integer
-	if _, err := ToUintE(value); err == nil {
+	if _, err := c.ToUintE(value); err == nil {
 		return uint64Type // Always return uint64 for unsigned integers
 	}
 
 	// Check if it's a float
-	if _, err := ToFloat32E(value); err == nil {
+	if _, err := c.ToFloat32E(value); err == nil {
 		return float32Type
 	}
-	if _, err := ToFloat64E(value); err == nil {
+	if _, err := c.ToFloat64E(value); err == nil {
 		return float64Type
 	}
 
 	// Check if it's a string last
-	if _, err := ToStringE(value); err == nil {
+	if _, err := c.ToStringE(value); err == nil {
 		return stringType
 	}
 
@@ -64,6 +64,56 @@ func TestToValue(t *testing.T) {
 	}
 }
 
+func TestToValueContainers(t *testing.T) {
+	f := 2.5
+	tests := []struct {
+		name   string
+		value  interface{}
+		to     reflect.Type
+		result interface{}
+	}{
+		{"SliceToInt8Slice", []interface{}{"1", 2}, reflect.TypeOf([]int8{}), []int8{1, 2}},
+		{"ArrayToStringSlice", [2]int{1, 2}, reflect.TypeOf([]string{}), []string{"1", "2"}},
+		{"SliceToUint16Array", []string{"1", "2", "3"}, reflect.TypeOf([4]uint16{}), [4]uint16{1, 2, 3, 0}},
+		{"MapToDurationSlices", map[string]interface{}{"a": []string{"1s", "2m"}}, reflect.TypeOf(map[string][]time.Duration{}),
+			map[string][]time.Duration{"a": {time.Second, 2 * time.Minute}}},
+		{"MapKeys", map[string]string{"1": "true"}, reflect.TypeOf(map[int]bool{}), map[int]bool{1: true}},
+		{"StringToFloatPointer", "2.5", reflect.TypeOf((*float64)(nil)), &f},
+		{"NestedPointers", []string{"2.5"}, reflect.TypeOf([]*float64{}), []*float64{&f}},
+		{"ValueToInterface", 42, reflect.TypeOf((*interface{})(nil)).Elem(), 42},
+		{"MapToStruct", map[string]interface{}{"Name": "pen", "Price": "1.5"}, reflect.TypeOf(decodeItem{}), decodeItem{"pen", 1.5}},
+		{"NilToSlice", nil, reflect.TypeOf([]int{}), []int(nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := ToValueE(tt.value, tt.to)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.result, value.Interface())
+			}
+		})
+	}
+
+	_, err := ToValueE([]interface{}{"1", "x"}, reflect.TypeOf([]int8{}))
+	assert.ErrorIs(t, err, ErrSyntax)
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, "[1]", convErr.Path)
+	}
+
+	_, err = ToValueE([]int{1, 2, 3}, reflect.TypeOf([2]int{}))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ToValueE(1, reflect.TypeOf((*error)(nil)).Elem())
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	_, err = ToValueE(make(chan int), reflect.TypeOf(make(chan int)))
+	assert.NoError(t, err)
+
+	_, err = ToValueE(1, reflect.TypeOf(make(chan int)))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
 func TestToJsonValue(t *testing.T) {
 
 	type Person struct {
@@ -234,3 +284,79 @@ func TestGetConvertType(t *testing.T) {
 		})
 	}
 }
+
+type namedStatus string
+type namedPort uint16
+type namedCelsius float64
+type namedFlag bool
+type namedConfig struct {
+	Status namedStatus               `convert:"status"`
+	Port   namedPort                 `convert:"port"`
+	Temps  []namedCelsius            `convert:"temps"`
+	Flags  map[namedStatus]namedFlag `convert:"flags"`
+}
+
+func TestNamedTypes(t *testing.T) {
+	t.Run("Sources", func(t *testing.T) {
+		assert.Equal(t, "active", ToString(namedStatus("active")))
+		assert.Equal(t, "8080", ToString(namedPort(8080)))
+		assert.Equal(t, "21.5", ToString(namedCelsius(21.5)))
+		assert.Equal(t, 8080, ToInt(namedPort(8080)))
+		assert.Equal(t, 42, ToInt(namedStatus("42")))
+		assert.Equal(t, 21.5, ToFloat64(namedCelsius(21.5)))
+		assert.Equal(t, true, ToBool(namedFlag(true)))
+		assert.Equal(t, int64(time.Second), ToInt64(time.Second))
+		assert.Equal(t, "1s", ToString(time.Second))
+
+		_, err := ToInt8E(namedPort(300))
+		assert.ErrorIs(t, err, ErrOverflow)
+		_, err = Strict().ToIntE(namedCelsius(21.5))
+		assert.ErrorIs(t, err, ErrPrecisionLoss)
+	})
+
+	t.Run("Targets", func(t *testing.T) {
+		v, err := ToValueE("8080", reflect.TypeOf(namedPort(0)))
+		if assert.NoError(t, err) {
+			assert.Exactly(t, namedPort(8080), v.Interface())
+		}
+
+		_, err = ToValueE(70000, reflect.TypeOf(namedPort(0)))
+		assert.ErrorIs(t, err, ErrOverflow)
+
+		v, err = ToValueE(1, reflect.TypeOf(namedStatus("")))
+		if assert.NoError(t, err) {
+			assert.Exactly(t, namedStatus("1"), v.Interface())
+		}
+
+		p, err := ToE[namedPort](namedCelsius(443))
+		assert.NoError(t, err)
+		assert.Exactly(t, namedPort(443), p)
+
+		temps, err := ToE[[]namedCelsius]([]string{"1.5", "2"})
+		assert.NoError(t, err)
+		assert.Equal(t, []namedCelsius{1.5, 2}, temps)
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		var cfg namedConfig
+		err := Decode(map[string]interface{}{
+			"status": "up",
+			"port":   "80",
+			"temps":  []interface{}{"20.5", 21},
+			"flags":  map[string]string{"debug": "yes"},
+		}, &cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, namedConfig{
+			Status: "up",
+			Port:   80,
+			Temps:  []namedCelsius{20.5, 21},
+			Flags:  map[namedStatus]namedFlag{"debug": true},
+		}, cfg)
+	})
+
+	t.Run("GetConvertType", func(t *testing.T) {
+		assert.Equal(t, float64Type, GetConvertType(namedCelsius(1.5)))
+		assert.Equal(t, int64Type, GetConvertType(namedCelsius(2)))
+		assert.NotPanics(t, func() { GetConvertType(namedStatus("x")) })
+	})
+}
@@ -0,0 +1,183 @@
+package convert
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// registryKey identifies a registered conversion by its source and target types.
+type registryKey struct {
+	from reflect.Type
+	to   reflect.Type
+}
+
+// registryFunc is the type-erased form of a registered conversion function.
+type registryFunc func(value interface{}) (interface{}, error)
+
+// registryEntry is a registered conversion whose source type is an interface.
+type registryEntry struct {
+	from reflect.Type
+	fn   registryFunc
+}
+
+// registrySnapshot is an immutable view of the registry.
+// Writers replace the whole snapshot so that readers never need to lock.
+type registrySnapshot struct {
+	exact      map[registryKey]registryFunc
+	interfaces map[reflect.Type][]registryEntry
+}
+
+var (
+	registry   atomic.Pointer[registrySnapshot]
+	registryMu sync.Mutex
+)
+
+// Register registers a conversion function from the source type S to the target type T.
+// The function is consulted by every ToXxxE function whose target is T, by ToValueE,
+// and by the slice and map families for their elements.
+// Custom converters passed at the call site still take precedence over registered functions.
+//
+// If S is an interface type, the function applies to any value implementing S
+// for which no exact registration exists.
+// Registering the same pair twice replaces the previous function.
+// Register is safe to call concurrently with conversions.
+//
+// Example:
+//
+//	convert.Register(func(id UserID) (string, error) {
+//		return "user-" + strconv.Itoa(int(id)), nil
+//	})
+//
+//	s := convert.ToString(UserID(42)) // "user-42"
+func Register[S, T any](fn func(src S) (T, error)) {
+	from := reflect.TypeOf((*S)(nil)).Elem()
+	to := reflect.TypeOf((*T)(nil)).Elem()
+
+	erased := func(value interface{}) (interface{}, error) {
+		return fn(value.(S))
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	next := registry.Load().clone()
+	if from.Kind() == reflect.Interface {
+		next.interfaces[to] = append(removeEntry(next.interfaces[to], from), registryEntry{from: from, fn: erased})
+	} else {
+		next.exact[registryKey{from: from, to: to}] = erased
+	}
+	registry.Store(next)
+}
+
+// Unregister removes the conversion function registered from S to T.
+// It reports whether a function was registered.
+func Unregister[S, T any]() bool {
+	from := reflect.TypeOf((*S)(nil)).Elem()
+	to := reflect.TypeOf((*T)(nil)).Elem()
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	next := registry.Load().clone()
+	if from.Kind() == reflect.Interface {
+		entries := next.interfaces[to]
+		remaining := removeEntry(entries, from)
+		if len(remaining) == len(entries) {
+			return false
+		}
+		if len(remaining) == 0 {
+			delete(next.interfaces, to)
+		} else {
+			next.interfaces[to] = remaining
+		}
+	} else {
+		key := registryKey{from: from, to: to}
+		if _, ok := next.exact[key]; !ok {
+			return false
+		}
+		delete(next.exact, key)
+	}
+	registry.Store(next)
+	return true
+}
+
+// clone returns a mutable copy of the snapshot.
+func (s *registrySnapshot) clone() *registrySnapshot {
+	next := &registrySnapshot{
+		exact:      make(map[registryKey]registryFunc),
+		interfaces: make(map[reflect.Type][]registryEntry),
+	}
+	if s == nil {
+		return next
+	}
+	for k, fn := range s.exact {
+		next.exact[k] = fn
+	}
+	for k, entries := range s.interfaces {
+		next.interfaces[k] = append([]registryEntry(nil), entries...)
+	}
+	return next
+}
+
+// empty reports whether the snapshot holds no registration.
+func (s *registrySnapshot) empty() bool {
+	return s == nil || (len(s.exact) == 0 && len(s.interfaces) == 0)
+}
+
+// removeEntry returns entries without the one registered for the given source type.
+func removeEntry(entries []registryEntry, from reflect.Type) []registryEntry {
+	res := make([]registryEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.from != from {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// lookupRegistry applies the function registered to convert value to the given type.
+// The boolean result reports whether such a function was found.
+// Pointers are dereferenced when no function is registered for the pointer type itself,
+// either exactly or through an interface it implements.
+func lookupRegistry(value interface{}, to reflect.Type) (interface{}, bool, error) {
+	snap := registry.Load()
+	if snap.empty() || value == nil {
+		return nil, false, nil
+	}
+
+	from := reflect.TypeOf(value)
+	if fn, ok := snap.exact[registryKey{from: from, to: to}]; ok {
+		res, err := fn(value)
+		return res, true, err
+	}
+
+	for _, entry := range snap.interfaces[to] {
+		if from.Implements(entry.from) {
+			res, err := entry.fn(value)
+			return res, true, err
+		}
+	}
+
+	if from.Kind() == reflect.Pointer {
+		if v := Indirect(value); v != nil && reflect.TypeOf(v).Kind() != reflect.Pointer {
+			return lookupRegistry(v, to)
+		}
+	}
+
+	return nil, false, nil
+}
+
+// fromRegistry is the typed counterpart of lookupRegistry used by the ToXxxE functions.
+func fromRegistry[T any](value interface{}) (T, bool, error) {
+	var zero T
+
+	res, ok, err := lookupRegistry(value, reflect.TypeOf((*T)(nil)).Elem())
+	if !ok {
+		return zero, false, nil
+	}
+	if err != nil || res == nil {
+		return zero, true, err
+	}
+	return res.(T), true, nil
+}
@@ -0,0 +1,177 @@
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type registryID int
+
+type registryCode struct {
+	prefix string
+	n      int
+}
+
+type registryLabel interface {
+	Label() string
+}
+
+type registryTag string
+
+func (t registryTag) Label() string {
+	return "tag:" + string(t)
+}
+
+// registryCounter implements registryLabel with a pointer receiver only.
+type registryCounter struct {
+	n int
+}
+
+func (c *registryCounter) Label() string {
+	return "counter:" + strconv.Itoa(c.n)
+}
+
+func TestRegister(t *testing.T) {
+	Register(func(id registryID) (string, error) {
+		return "id-" + strconv.Itoa(int(id)), nil
+	})
+	defer Unregister[registryID, string]()
+
+	Register(func(c registryCode) (int, error) {
+		if c.n < 0 {
+			return 0, errors.New("negative code")
+		}
+		return c.n, nil
+	})
+	defer Unregister[registryCode, int]()
+
+	t.Run("scalar", func(t *testing.T) {
+		s, err := ToStringE(registryID(42))
+		assert.NoError(t, err)
+		assert.Equal(t, "id-42", s)
+
+		n, err := ToIntE(registryCode{"A", 7})
+		assert.NoError(t, err)
+		assert.Equal(t, 7, n)
+
+		_, err = ToIntE(registryCode{"A", -1})
+		assert.EqualError(t, err, "negative code")
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		id := registryID(1)
+		assert.Equal(t, "id-1", ToString(&id))
+	})
+
+	t.Run("custom converter takes precedence", func(t *testing.T) {
+		converter := func(value interface{}) *string {
+			s := "custom"
+			return &s
+		}
+		assert.Equal(t, "custom", ToString(registryID(1), converter))
+	})
+
+	t.Run("ToValueE", func(t *testing.T) {
+		v, err := ToValueE(registryID(3), stringType)
+		assert.NoError(t, err)
+		assert.Equal(t, "id-3", v.Interface())
+	})
+
+	t.Run("generic", func(t *testing.T) {
+		assert.Equal(t, "id-5", To[string](registryID(5)))
+	})
+
+	t.Run("elements", func(t *testing.T) {
+		strs, err := ToSliceStringE([]registryID{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id-1", "id-2"}, strs)
+
+		ints, err := ToSliceIntE([]interface{}{registryCode{"A", 1}, "2"})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, ints)
+
+		m, err := ToMapStringStringE(map[string]registryID{"a": 1})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "id-1"}, m)
+
+		arr, err := ToIntArrayE([]registryCode{{"A", 4}})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4}, arr)
+	})
+}
+
+func TestRegisterInterfaceSource(t *testing.T) {
+	Register(func(l registryLabel) (string, error) {
+		return l.Label(), nil
+	})
+	defer Unregister[registryLabel, string]()
+
+	assert.Equal(t, "tag:x", ToString(registryTag("x")))
+}
+
+func TestRegisterPointerReceiverInterface(t *testing.T) {
+	Register(func(l registryLabel) (int, error) {
+		return len(l.Label()), nil
+	})
+	defer Unregister[registryLabel, int]()
+
+	n, err := ToIntE(&registryCounter{n: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, len("counter:7"), n)
+
+	tag := registryTag("x")
+	n, err = ToIntE(&tag)
+	assert.NoError(t, err)
+	assert.Equal(t, len("tag:x"), n)
+}
+
+func TestRegisterReplace(t *testing.T) {
+	Register(func(id registryID) (int64, error) { return 1, nil })
+	Register(func(id registryID) (int64, error) { return 2, nil })
+	defer Unregister[registryID, int64]()
+
+	assert.Equal(t, int64(2), ToInt64(registryID(0)))
+}
+
+func TestUnregister(t *testing.T) {
+	Register(func(id registryID) (float64, error) { return 1.5, nil })
+	assert.Equal(t, 1.5, ToFloat64(registryID(0)))
+
+	assert.True(t, Unregister[registryID, float64]())
+	assert.False(t, Unregister[registryID, float64]())
+	assert.Equal(t, float64(0), ToFloat64(registryID(0)))
+
+	assert.False(t, Unregister[registryLabel, float64]())
+}
+
+func TestRegisterConcurrent(t *testing.T) {
+	type concurrentID int
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			Register(func(id concurrentID) (string, error) {
+				return fmt.Sprintf("c%d", int(id)), nil
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, _ = ToStringE(concurrentID(j))
+				_, _ = ToValueE(concurrentID(j), reflect.TypeOf(""))
+			}
+		}()
+	}
+	wg.Wait()
+	defer Unregister[concurrentID, string]()
+
+	assert.Equal(t, "c7", ToString(concurrentID(7)))
+}
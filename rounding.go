@@ -0,0 +1,140 @@
+package convert
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode is the policy applied when a float is converted to an integer.
+type RoundingMode int
+
+const (
+	// RoundTruncate discards the fractional part (rounds toward zero). It is the default.
+	RoundTruncate RoundingMode = iota
+	// RoundError refuses floats with a fractional part with ErrPrecisionLoss.
+	// It also makes ToFloat32E refuse float64 values that float32 cannot represent exactly.
+	RoundError
+	// RoundHalfUp rounds to the nearest integer, with halves away from zero.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest integer, with halves to the even neighbour.
+	RoundHalfEven
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+)
+
+// String returns the name of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundTruncate:
+		return "truncate"
+	case RoundError:
+		return "error"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	default:
+		return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+	}
+}
+
+// integer is the set of integer types produced by the numeric converters.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// round rounds f to an integral value according to the rounding mode of c.
+func (c *Converter) round(f float64) (float64, error) {
+	switch c.opts.rounding {
+	case RoundError:
+		if f != math.Trunc(f) {
+			return 0, ErrPrecisionLoss
+		}
+		return f, nil
+	case RoundHalfUp:
+		return math.Round(f), nil
+	case RoundHalfEven:
+		return math.RoundToEven(f), nil
+	case RoundFloor:
+		return math.Floor(f), nil
+	case RoundCeil:
+		return math.Ceil(f), nil
+	default:
+		return math.Trunc(f), nil
+	}
+}
+
+// floatToInteger converts f to the integer type T with the rounding mode of c.
+// NaN, infinities and values outside the range of T are reported as ErrOverflow.
+func floatToInteger[T integer](c *Converter, value interface{}, f float64) (T, error) {
+	to := reflect.TypeFor[T]()
+	if math.IsNaN(f) {
+		return 0, nanError(value, to)
+	}
+	if math.IsInf(f, 0) {
+		return 0, overflowError(value, to, int(math.Copysign(1, f)))
+	}
+	r, err := c.round(f)
+	if err != nil {
+		return 0, newError(value, to, err)
+	}
+	lo, hi := integerBounds(to)
+	if r < lo || r >= hi {
+		return 0, overflowError(value, to, int(math.Copysign(1, r)))
+	}
+	return T(r), nil
+}
+
+// integerBounds returns the smallest value of the integer type t and the power of two just above its largest value.
+// Both are exact float64 values.
+func integerBounds(t reflect.Type) (lo, hi float64) {
+	size := t.Bits()
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return 0, math.Ldexp(1, size)
+	default:
+		return -math.Ldexp(1, size-1), math.Ldexp(1, size-1)
+	}
+}
+
+// narrowFloat32 converts f to float32.
+// Finite values beyond the float32 range are reported as ErrOverflow.
+// When exact is set and the rounding mode is RoundError, values that float32 cannot
+// represent exactly are reported as ErrPrecisionLoss.
+func (c *Converter) narrowFloat32(value interface{}, f float64, exact bool) (float32, error) {
+	res := float32(f)
+	if math.IsInf(float64(res), 0) && !math.IsInf(f, 0) {
+		return 0, newError(value, reflect.TypeFor[float32](), ErrOverflow)
+	}
+	if exact && c.opts.rounding == RoundError && !math.IsNaN(f) && float64(res) != f {
+		return 0, newError(value, reflect.TypeFor[float32](), ErrPrecisionLoss)
+	}
+	return res, nil
+}
+
+// parseFloat parses s as a float64.
+// Unlike strconv.ParseFloat, it accepts values beyond the float64 range, returned as
+// ±Inf (or ±0 below the smallest magnitude), so that callers report them as overflows.
+func parseFloat(s string) (float64, bool) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return f, true
+	}
+	var numErr *strconv.NumError
+	return f, errors.As(err, &numErr) && numErr.Err == strconv.ErrRange
+}
+
+// isInfString reports whether s spells an infinity, like "Inf" or "-infinity".
+func isInfString(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	return strings.EqualFold(s, "inf") || strings.EqualFold(s, "infinity")
+}
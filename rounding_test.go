@@ -0,0 +1,115 @@
+package convert
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundingModes(t *testing.T) {
+	tests := []struct {
+		mode  RoundingMode
+		input interface{}
+		want  int64
+	}{
+		{RoundTruncate, 2.7, 2},
+		{RoundTruncate, -2.7, -2},
+		{RoundHalfUp, 2.5, 3},
+		{RoundHalfUp, -2.5, -3},
+		{RoundHalfUp, "2.4", 2},
+		{RoundHalfEven, 2.5, 2},
+		{RoundHalfEven, 3.5, 4},
+		{RoundHalfEven, "-2.5", -2},
+		{RoundFloor, 2.7, 2},
+		{RoundFloor, -2.2, -3},
+		{RoundCeil, 2.2, 3},
+		{RoundCeil, "-2.7", -2},
+		{RoundError, 4.0, 4},
+		{RoundError, "4.0", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			got, err := New(WithRounding(tt.mode)).ToInt64E(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got, "input %v", tt.input)
+		})
+	}
+}
+
+func TestRoundingError(t *testing.T) {
+	c := New(WithRounding(RoundError))
+
+	_, err := c.ToIntE(2.5)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = c.ToUint32E("7.25")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = c.ToFloat32E(0.1)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	f, err := c.ToFloat32E(0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.5), f)
+
+	f, err = c.ToFloat32E("0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0.1), f)
+}
+
+func TestFloatOverflow(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() error
+	}{
+		{"int from NaN", func() error { _, err := ToIntE(math.NaN()); return err }},
+		{"int64 from +Inf", func() error { _, err := ToInt64E(math.Inf(1)); return err }},
+		{"int32 from -Inf string", func() error { _, err := ToInt32E("-Inf"); return err }},
+		{"int64 from 2^63", func() error { _, err := ToInt64E(math.Ldexp(1, 63)); return err }},
+		{"uint64 from 2^64", func() error { _, err := ToUint64E(math.Ldexp(1, 64)); return err }},
+		{"uint from negative", func() error { _, err := ToUintE(-1.5); return err }},
+		{"int8 from 128.5", func() error { _, err := ToInt8E(128.5); return err }},
+		{"uint16 from string", func() error { _, err := ToUint16E("70000.5"); return err }},
+		{"int from huge string", func() error { _, err := ToIntE("1e400"); return err }},
+		{"float32 from float64", func() error { _, err := ToFloat32E(1e300); return err }},
+		{"float32 from string", func() error { _, err := ToFloat32E("1e39"); return err }},
+		{"float64 from string", func() error { _, err := ToFloat64E("1e400"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.convert(), ErrOverflow)
+		})
+	}
+}
+
+func TestFloatBounds(t *testing.T) {
+	n, err := ToInt8E(-128.9)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-128), n)
+
+	i, err := ToInt8E("-3.5")
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-3), i)
+
+	u, err := ToUint8E(255.9)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(255), u)
+
+	_, err = New(WithRounding(RoundHalfUp)).ToUint8E(255.5)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	n64, err := ToInt64E(-math.Ldexp(1, 63))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), n64)
+
+	f, err := ToFloat64E("-Inf")
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(f, -1))
+
+	f32, err := ToFloat32E(math.Inf(1))
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(float64(f32), 1))
+}
@@ -0,0 +1,305 @@
+package convert
+
+import (
+	"errors"
+	"reflect"
+)
+
+// WithSaturation sets whether the integer converters clamp out-of-range values to the
+// nearest bound of the target type instead of reporting ErrOverflow: 300 gives 255 as a uint8,
+// -1e9 gives -128 as an int8, and negative values give 0 as unsigned types.
+// Infinities are clamped like the other values, and NaN, which has no nearest bound, gives 0.
+// Other errors, like syntax errors or the precision errors of strict mode, are kept.
+// It is disabled by default; the ToXxxSaturate functions saturate on a per-call basis.
+func WithSaturation(enabled bool) Option {
+	return func(o *options) {
+		o.saturate = enabled
+	}
+}
+
+// saturate replaces an ErrOverflow error of a conversion to T with the nearest bound of T,
+// if c saturates and the direction of the overflow is known, and NaN with 0.
+func saturate[T integer](c *Converter, res *T, err *error) {
+	if c.opts.saturate {
+		*res, *err = saturated(*res, *err)
+	}
+}
+
+// saturated returns res, or the nearest bound of T if err is an ErrOverflow whose
+// direction is known, and 0 if it is the ErrOverflow of NaN.
+func saturated[T integer](res T, err error) (T, error) {
+	if !errors.Is(err, ErrOverflow) {
+		return res, err
+	}
+	if isNaNOverflow(err) {
+		return 0, nil
+	}
+	if bound, ok := clamp[T](overflowSign(err)); ok {
+		return bound, nil
+	}
+	return res, err
+}
+
+// clamp returns the smallest value of T for a negative sign and the largest one for a
+// positive sign. It reports false for a zero sign.
+func clamp[T integer](sign int) (T, bool) {
+	var lo, hi T
+	hi = ^hi
+	if hi < 0 {
+		// For signed types, all bits set is -1.
+		hi = T(uint64(1)<<(reflect.TypeFor[T]().Bits()-1) - 1)
+		lo = -hi - 1
+	}
+	switch {
+	case sign < 0:
+		return lo, true
+	case sign > 0:
+		return hi, true
+	default:
+		return 0, false
+	}
+}
+
+// ToIntSaturate converts any type of value to int, clamping out-of-range values
+// to the bounds of int, and ignoring other errors.
+func ToIntSaturate(value interface{}) int {
+	return std.ToIntSaturate(value)
+}
+
+// ToIntSaturate is like the package-level ToIntSaturate but uses the options of c.
+func (c *Converter) ToIntSaturate(value interface{}) int {
+	res, _ := c.ToIntSaturateE(value)
+	return res
+}
+
+// ToIntSaturateE is like ToIntE, but clamps out-of-range values to the bounds of int
+// as WithSaturation does.
+func ToIntSaturateE(value interface{}) (int, error) {
+	return std.ToIntSaturateE(value)
+}
+
+// ToIntSaturateE is like the package-level ToIntSaturateE but uses the options of c.
+func (c *Converter) ToIntSaturateE(value interface{}) (int, error) {
+	res, err := c.ToIntE(value)
+	return saturated(res, err)
+}
+
+// ToInt8Saturate converts any type of value to int8, clamping out-of-range values
+// to the bounds of int8, and ignoring other errors.
+func ToInt8Saturate(value interface{}) int8 {
+	return std.ToInt8Saturate(value)
+}
+
+// ToInt8Saturate is like the package-level ToInt8Saturate but uses the options of c.
+func (c *Converter) ToInt8Saturate(value interface{}) int8 {
+	res, _ := c.ToInt8SaturateE(value)
+	return res
+}
+
+// ToInt8SaturateE is like ToInt8E, but clamps out-of-range values to the bounds of int8
+// as WithSaturation does.
+func ToInt8SaturateE(value interface{}) (int8, error) {
+	return std.ToInt8SaturateE(value)
+}
+
+// ToInt8SaturateE is like the package-level ToInt8SaturateE but uses the options of c.
+func (c *Converter) ToInt8SaturateE(value interface{}) (int8, error) {
+	res, err := c.ToInt8E(value)
+	return saturated(res, err)
+}
+
+// ToInt16Saturate converts any type of value to int16, clamping out-of-range values
+// to the bounds of int16, and ignoring other errors.
+func ToInt16Saturate(value interface{}) int16 {
+	return std.ToInt16Saturate(value)
+}
+
+// ToInt16Saturate is like the package-level ToInt16Saturate but uses the options of c.
+func (c *Converter) ToInt16Saturate(value interface{}) int16 {
+	res, _ := c.ToInt16SaturateE(value)
+	return res
+}
+
+// ToInt16SaturateE is like ToInt16E, but clamps out-of-range values to the bounds of int16
+// as WithSaturation does.
+func ToInt16SaturateE(value interface{}) (int16, error) {
+	return std.ToInt16SaturateE(value)
+}
+
+// ToInt16SaturateE is like the package-level ToInt16SaturateE but uses the options of c.
+func (c *Converter) ToInt16SaturateE(value interface{}) (int16, error) {
+	res, err := c.ToInt16E(value)
+	return saturated(res, err)
+}
+
+// ToInt32Saturate converts any type of value to int32, clamping out-of-range values
+// to the bounds of int32, and ignoring other errors.
+func ToInt32Saturate(value interface{}) int32 {
+	return std.ToInt32Saturate(value)
+}
+
+// ToInt32Saturate is like the package-level ToInt32Saturate but uses the options of c.
+func (c *Converter) ToInt32Saturate(value interface{}) int32 {
+	res, _ := c.ToInt32SaturateE(value)
+	return res
+}
+
+// ToInt32SaturateE is like ToInt32E, but clamps out-of-range values to the bounds of int32
+// as WithSaturation does.
+func ToInt32SaturateE(value interface{}) (int32, error) {
+	return std.ToInt32SaturateE(value)
+}
+
+// ToInt32SaturateE is like the package-level ToInt32SaturateE but uses the options of c.
+func (c *Converter) ToInt32SaturateE(value interface{}) (int32, error) {
+	res, err := c.ToInt32E(value)
+	return saturated(res, err)
+}
+
+// ToInt64Saturate converts any type of value to int64, clamping out-of-range values
+// to the bounds of int64, and ignoring other errors.
+func ToInt64Saturate(value interface{}) int64 {
+	return std.ToInt64Saturate(value)
+}
+
+// ToInt64Saturate is like the package-level ToInt64Saturate but uses the options of c.
+func (c *Converter) ToInt64Saturate(value interface{}) int64 {
+	res, _ := c.ToInt64SaturateE(value)
+	return res
+}
+
+// ToInt64SaturateE is like ToInt64E, but clamps out-of-range values to the bounds of int64
+// as WithSaturation does.
+func ToInt64SaturateE(value interface{}) (int64, error) {
+	return std.ToInt64SaturateE(value)
+}
+
+// ToInt64SaturateE is like the package-level ToInt64SaturateE but uses the options of c.
+func (c *Converter) ToInt64SaturateE(value interface{}) (int64, error) {
+	res, err := c.ToInt64E(value)
+	return saturated(res, err)
+}
+
+// ToUintSaturate converts any type of value to uint, clamping out-of-range values
+// to the bounds of uint, and ignoring other errors.
+func ToUintSaturate(value interface{}) uint {
+	return std.ToUintSaturate(value)
+}
+
+// ToUintSaturate is like the package-level ToUintSaturate but uses the options of c.
+func (c *Converter) ToUintSaturate(value interface{}) uint {
+	res, _ := c.ToUintSaturateE(value)
+	return res
+}
+
+// ToUintSaturateE is like ToUintE, but clamps out-of-range values to the bounds of uint
+// as WithSaturation does.
+func ToUintSaturateE(value interface{}) (uint, error) {
+	return std.ToUintSaturateE(value)
+}
+
+// ToUintSaturateE is like the package-level ToUintSaturateE but uses the options of c.
+func (c *Converter) ToUintSaturateE(value interface{}) (uint, error) {
+	res, err := c.ToUintE(value)
+	return saturated(res, err)
+}
+
+// ToUint8Saturate converts any type of value to uint8, clamping out-of-range values
+// to the bounds of uint8, and ignoring other errors.
+//
+// Example:
+//
+//	fmt.Println(ToUint8Saturate(300), ToUint8Saturate(-5)) // Output: 255 0
+func ToUint8Saturate(value interface{}) uint8 {
+	return std.ToUint8Saturate(value)
+}
+
+// ToUint8Saturate is like the package-level ToUint8Saturate but uses the options of c.
+func (c *Converter) ToUint8Saturate(value interface{}) uint8 {
+	res, _ := c.ToUint8SaturateE(value)
+	return res
+}
+
+// ToUint8SaturateE is like ToUint8E, but clamps out-of-range values to the bounds of uint8
+// as WithSaturation does.
+func ToUint8SaturateE(value interface{}) (uint8, error) {
+	return std.ToUint8SaturateE(value)
+}
+
+// ToUint8SaturateE is like the package-level ToUint8SaturateE but uses the options of c.
+func (c *Converter) ToUint8SaturateE(value interface{}) (uint8, error) {
+	res, err := c.ToUint8E(value)
+	return saturated(res, err)
+}
+
+// ToUint16Saturate converts any type of value to uint16, clamping out-of-range values
+// to the bounds of uint16, and ignoring other errors.
+func ToUint16Saturate(value interface{}) uint16 {
+	return std.ToUint16Saturate(value)
+}
+
+// ToUint16Saturate is like the package-level ToUint16Saturate but uses the options of c.
+func (c *Converter) ToUint16Saturate(value interface{}) uint16 {
+	res, _ := c.ToUint16SaturateE(value)
+	return res
+}
+
+// ToUint16SaturateE is like ToUint16E, but clamps out-of-range values to the bounds of uint16
+// as WithSaturation does.
+func ToUint16SaturateE(value interface{}) (uint16, error) {
+	return std.ToUint16SaturateE(value)
+}
+
+// ToUint16SaturateE is like the package-level ToUint16SaturateE but uses the options of c.
+func (c *Converter) ToUint16SaturateE(value interface{}) (uint16, error) {
+	res, err := c.ToUint16E(value)
+	return saturated(res, err)
+}
+
+// ToUint32Saturate converts any type of value to uint32, clamping out-of-range values
+// to the bounds of uint32, and ignoring other errors.
+func ToUint32Saturate(value interface{}) uint32 {
+	return std.ToUint32Saturate(value)
+}
+
+// ToUint32Saturate is like the package-level ToUint32Saturate but uses the options of c.
+func (c *Converter) ToUint32Saturate(value interface{}) uint32 {
+	res, _ := c.ToUint32SaturateE(value)
+	return res
+}
+
+// ToUint32SaturateE is like ToUint32E, but clamps out-of-range values to the bounds of uint32
+// as WithSaturation does.
+func ToUint32SaturateE(value interface{}) (uint32, error) {
+	return std.ToUint32SaturateE(value)
+}
+
+// ToUint32SaturateE is like the package-level ToUint32SaturateE but uses the options of c.
+func (c *Converter) ToUint32SaturateE(value interface{}) (uint32, error) {
+	res, err := c.ToUint32E(value)
+	return saturated(res, err)
+}
+
+// ToUint64Saturate converts any type of value to uint64, clamping out-of-range values
+// to the bounds of uint64, and ignoring other errors.
+func ToUint64Saturate(value interface{}) uint64 {
+	return std.ToUint64Saturate(value)
+}
+
+// ToUint64Saturate is like the package-level ToUint64Saturate but uses the options of c.
+func (c *Converter) ToUint64Saturate(value interface{}) uint64 {
+	res, _ := c.ToUint64SaturateE(value)
+	return res
+}
+
+// ToUint64SaturateE is like ToUint64E, but clamps out-of-range values to the bounds of uint64
+// as WithSaturation does.
+func ToUint64SaturateE(value interface{}) (uint64, error) {
+	return std.ToUint64SaturateE(value)
+}
+
+// ToUint64SaturateE is like the package-level ToUint64SaturateE but uses the options of c.
+func (c *Converter) ToUint64SaturateE(value interface{}) (uint64, error) {
+	res, err := c.ToUint64E(value)
+	return saturated(res, err)
+}
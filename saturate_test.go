@@ -0,0 +1,107 @@
+package convert
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaturateFunctions(t *testing.T) {
+	assert.Equal(t, uint8(255), ToUint8Saturate(300))
+	assert.Equal(t, uint8(0), ToUint8Saturate(-5))
+	assert.Equal(t, uint8(42), ToUint8Saturate("42"))
+	assert.Equal(t, int8(-128), ToInt8Saturate(-1e9))
+	assert.Equal(t, int8(127), ToInt8Saturate("1e400"))
+	assert.Equal(t, int16(math.MaxInt16), ToInt16Saturate(uint64(math.MaxUint64)))
+	assert.Equal(t, int32(math.MinInt32), ToInt32Saturate(math.Inf(-1)))
+	assert.Equal(t, int64(math.MaxInt64), ToInt64Saturate(new(big.Int).Lsh(big.NewInt(1), 100)))
+	assert.Equal(t, int(math.MinInt), ToIntSaturate("-99999999999999999999"))
+	assert.Equal(t, uint(math.MaxUint), ToUintSaturate(math.Inf(1)))
+	assert.Equal(t, uint16(0), ToUint16Saturate(math.NaN()))
+	assert.Equal(t, uint32(math.MaxUint32), ToUint32Saturate(float32(1e20)))
+	assert.Equal(t, uint64(0), ToUint64Saturate(int64(math.MinInt64)))
+	assert.Equal(t, uint8(255), ToUint8Saturate(namedPort(8080)))
+
+	_, err := ToInt8SaturateE("abc")
+	assert.ErrorIs(t, err, ErrSyntax)
+	_, err = ToUint8E(300)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	res, err := Strict().ToInt8SaturateE(1000.5)
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	assert.Equal(t, int8(0), res)
+	res, err = Strict().ToInt8SaturateE(1000)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(127), res)
+}
+
+func TestWithSaturation(t *testing.T) {
+	c := New(WithSaturation(true))
+	assert.Equal(t, uint8(255), c.ToUint8(300))
+	assert.Equal(t, int8(-128), c.ToInt8("-129"))
+	assert.Equal(t, uint32(0), c.ToUint32(math.NaN()))
+	assert.Equal(t, int64(math.MaxInt64), c.ToInt64(uint64(math.MaxUint64)))
+	assert.Equal(t, int64(math.MaxInt64), c.ToInt64(1e300))
+
+	v, err := c.ToSliceIntE([]interface{}{"1e30", -1e30, 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{math.MaxInt, math.MinInt, 5}, v)
+
+	var pixel struct {
+		R uint8 `convert:"r"`
+		G uint8 `convert:"g"`
+	}
+	assert.NoError(t, c.Decode(map[string]interface{}{"r": 512.7, "g": -3}, &pixel))
+	assert.Equal(t, uint8(255), pixel.R)
+	assert.Equal(t, uint8(0), pixel.G)
+
+	n, err := ToWithE[int16](c, 1<<20)
+	assert.NoError(t, err)
+	assert.Equal(t, int16(math.MaxInt16), n)
+
+	assert.Equal(t, 0, c.ToIntOrDefault("x", 0))
+	_, err = New(WithSaturation(false)).ToUint8E(300)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestSaturateOverflowSign(t *testing.T) {
+	_, err := ToInt8E("-1e30")
+	assert.Equal(t, -1, overflowSign(err))
+	_, err = ToUint8E(-1)
+	assert.Equal(t, -1, overflowSign(err))
+	_, err = ToInt16E(uint64(math.MaxUint64))
+	assert.Equal(t, 1, overflowSign(err))
+	_, err = ToInt32E(big.NewFloat(math.Inf(-1)))
+	assert.Equal(t, -1, overflowSign(err))
+	_, err = ToSliceIntE([]interface{}{1, "1e30"})
+	assert.Equal(t, 1, overflowSign(err))
+
+	_, err = ToInt64E(math.NaN())
+	assert.ErrorIs(t, err, ErrOverflow)
+	assert.Equal(t, 0, overflowSign(err))
+}
+
+func TestSaturateNaN(t *testing.T) {
+	u8, err := ToUint8SaturateE(math.NaN())
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(0), u8)
+	i32, err := ToInt32SaturateE(float32(math.NaN()))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), i32)
+	u, err := ToUintSaturateE("NaN")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), u)
+
+	c := New(WithSaturation(true))
+	i64, err := c.ToInt64E(math.NaN())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), i64)
+	i8, err := c.ToInt8E("nan")
+	assert.NoError(t, err)
+	assert.Equal(t, int8(0), i8)
+	ints, err := c.ToSliceIntE([]interface{}{1, math.NaN()})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, ints)
+}
@@ -2,7 +2,7 @@ package convert
 
 import (
 	"encoding/json"
-	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -36,11 +36,21 @@ type SliceTimeConverter func(value interface{}) *[]time.Time
 // This allows for flexible, user-defined conversion logic.
 type SliceDurationConverter func(value interface{}) *[]time.Duration
 
+// SliceByteSizeConverter is a function type for custom conversion of []ByteSize.
+// It takes any value and returns a pointer to a []ByteSize if the conversion succeeds, or nil if it fails.
+// This allows for flexible, user-defined conversion logic.
+type SliceByteSizeConverter func(value interface{}) *[]ByteSize
+
 // ToSliceString converts any type of value to []string.
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion fails, it returns an empty slice.
 func ToSliceString(value interface{}, converters ...SliceStringConverter) []string {
-	res, _ := ToSliceStringE(value, converters...)
+	return std.ToSliceString(value, converters...)
+}
+
+// ToSliceString is like the package-level ToSliceString but uses the options of c.
+func (c *Converter) ToSliceString(value interface{}, converters ...SliceStringConverter) []string {
+	res, _ := c.ToSliceStringE(value, converters...)
 	return res
 }
 
@@ -48,10 +58,15 @@ func ToSliceString(value interface{}, converters ...SliceStringConverter) []stri
 // It takes a value of any type, a default value of type []string, and a variable number of custom converters.
 // If the input value is nil or if the conversion fails, it returns the default value.
 func ToSliceStringOrDefault(value interface{}, defaultValue []string, converters ...SliceStringConverter) []string {
+	return std.ToSliceStringOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceStringOrDefault is like the package-level ToSliceStringOrDefault but uses the options of c.
+func (c *Converter) ToSliceStringOrDefault(value interface{}, defaultValue []string, converters ...SliceStringConverter) []string {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToSliceStringE(value, converters...)
+	res, _ := c.ToSliceStringE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -63,11 +78,19 @@ func ToSliceStringOrDefault(value interface{}, defaultValue []string, converters
 // If the conversion succeeds, it returns the resulting []string and a nil error.
 // If the conversion fails, it returns nil and an error describing the problem.
 func ToSliceStringE(value interface{}, converters ...SliceStringConverter) ([]string, error) {
+	return std.ToSliceStringE(value, converters...)
+}
+
+// ToSliceStringE is like the package-level ToSliceStringE but uses the options of c.
+func (c *Converter) ToSliceStringE(value interface{}, converters ...SliceStringConverter) ([]string, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[[]string]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -75,31 +98,38 @@ func ToSliceStringE(value interface{}, converters ...SliceStringConverter) ([]st
 		}
 	}
 
+	if res, ok, err := fromRegistry[[]string](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case []string:
 		return v, nil
 	case []interface{}:
 		res := make([]string, len(v))
 		for i, val := range v {
-			res[i] = ToString(val)
+			res[i] = c.ToString(val)
 		}
 		return res, nil
 	case string:
 		var res []string
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]string](), syntaxError(err))
 		}
 		return res, nil
 	case []byte:
 		var res []string
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]string](), syntaxError(err))
 		}
 		return res, nil
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertSlice(i, func(val interface{}) (string, error) { return c.ToStringE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]string]())
 	}
 }
 
@@ -107,7 +137,12 @@ func ToSliceStringE(value interface{}, converters ...SliceStringConverter) ([]st
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion fails, it returns an empty slice.
 func ToSliceInterface(value interface{}, converters ...SliceInterfaceConverter) []interface{} {
-	res, _ := ToSliceInterfaceE(value, converters...)
+	return std.ToSliceInterface(value, converters...)
+}
+
+// ToSliceInterface is like the package-level ToSliceInterface but uses the options of c.
+func (c *Converter) ToSliceInterface(value interface{}, converters ...SliceInterfaceConverter) []interface{} {
+	res, _ := c.ToSliceInterfaceE(value, converters...)
 	return res
 }
 
@@ -115,10 +150,15 @@ func ToSliceInterface(value interface{}, converters ...SliceInterfaceConverter)
 // It takes a value of any type, a default value of type []interface{}, and a variable number of custom converters.
 // If the input value is nil or if the conversion fails, it returns the default value.
 func ToSliceInterfaceOrDefault(value interface{}, defaultValue []interface{}, converters ...SliceInterfaceConverter) []interface{} {
+	return std.ToSliceInterfaceOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceInterfaceOrDefault is like the package-level ToSliceInterfaceOrDefault but uses the options of c.
+func (c *Converter) ToSliceInterfaceOrDefault(value interface{}, defaultValue []interface{}, converters ...SliceInterfaceConverter) []interface{} {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToSliceInterfaceE(value, converters...)
+	res, _ := c.ToSliceInterfaceE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -129,12 +169,21 @@ func ToSliceInterfaceOrDefault(value interface{}, defaultValue []interface{}, co
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion succeeds, it returns the resulting []interface{} and a nil error.
 // If the conversion fails, it returns nil and an error describing the problem.
+// Strings and []byte are decoded as JSON, numbers being decoded as set by WithJSONNumbers.
 func ToSliceInterfaceE(value interface{}, converters ...SliceInterfaceConverter) ([]interface{}, error) {
+	return std.ToSliceInterfaceE(value, converters...)
+}
+
+// ToSliceInterfaceE is like the package-level ToSliceInterfaceE but uses the options of c.
+func (c *Converter) ToSliceInterfaceE(value interface{}, converters ...SliceInterfaceConverter) ([]interface{}, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[[]interface{}]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -142,25 +191,25 @@ func ToSliceInterfaceE(value interface{}, converters ...SliceInterfaceConverter)
 		}
 	}
 
+	if res, ok, err := fromRegistry[[]interface{}](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case []interface{}:
 		return v, nil
-	case string:
+	case string, []byte:
 		var res []interface{}
-		err := json.Unmarshal([]byte(v), &res)
+		err := c.unmarshalJSON(v, &res)
 		if err != nil {
-			return nil, err
-		}
-		return res, nil
-	case []byte:
-		var res []interface{}
-		err := json.Unmarshal(v, &res)
-		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]interface{}](), syntaxError(err))
 		}
 		return res, nil
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertSlice(i, func(val interface{}) (interface{}, error) { return val, nil }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]interface{}]())
 	}
 }
 
@@ -168,7 +217,12 @@ func ToSliceInterfaceE(value interface{}, converters ...SliceInterfaceConverter)
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion fails, it returns an empty slice.
 func ToSliceBool(value interface{}, converters ...SliceBoolConverter) []bool {
-	res, _ := ToSliceBoolE(value, converters...)
+	return std.ToSliceBool(value, converters...)
+}
+
+// ToSliceBool is like the package-level ToSliceBool but uses the options of c.
+func (c *Converter) ToSliceBool(value interface{}, converters ...SliceBoolConverter) []bool {
+	res, _ := c.ToSliceBoolE(value, converters...)
 	return res
 }
 
@@ -176,10 +230,15 @@ func ToSliceBool(value interface{}, converters ...SliceBoolConverter) []bool {
 // It takes a value of any type, a default value of type []bool, and a variable number of custom converters.
 // If the input value is nil or if the conversion fails, it returns the default value.
 func ToSliceBoolOrDefault(value interface{}, defaultValue []bool, converters ...SliceBoolConverter) []bool {
+	return std.ToSliceBoolOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceBoolOrDefault is like the package-level ToSliceBoolOrDefault but uses the options of c.
+func (c *Converter) ToSliceBoolOrDefault(value interface{}, defaultValue []bool, converters ...SliceBoolConverter) []bool {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToSliceBoolE(value, converters...)
+	res, _ := c.ToSliceBoolE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -191,11 +250,19 @@ func ToSliceBoolOrDefault(value interface{}, defaultValue []bool, converters ...
 // If the conversion succeeds, it returns the resulting []bool and a nil error.
 // If the conversion fails, it returns nil and an error describing the problem.
 func ToSliceBoolE(value interface{}, converters ...SliceBoolConverter) ([]bool, error) {
+	return std.ToSliceBoolE(value, converters...)
+}
+
+// ToSliceBoolE is like the package-level ToSliceBoolE but uses the options of c.
+func (c *Converter) ToSliceBoolE(value interface{}, converters ...SliceBoolConverter) ([]bool, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[[]bool]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -203,15 +270,19 @@ func ToSliceBoolE(value interface{}, converters ...SliceBoolConverter) ([]bool,
 		}
 	}
 
+	if res, ok, err := fromRegistry[[]bool](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case []bool:
 		return v, nil
 	case []interface{}:
 		res := make([]bool, len(v))
 		for i, val := range v {
-			boolVal, err := ToBoolE(val)
+			boolVal, err := c.ToBoolE(val)
 			if err != nil {
-				return nil, fmt.Errorf("unable to convert element %d to bool: %v", i, err)
+				return nil, pathError(err, indexPath(i), val, reflect.TypeFor[bool]())
 			}
 			res[i] = boolVal
 		}
@@ -220,18 +291,21 @@ func ToSliceBoolE(value interface{}, converters ...SliceBoolConverter) ([]bool,
 		var res []bool
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]bool](), syntaxError(err))
 		}
 		return res, nil
 	case []byte:
 		var res []bool
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]bool](), syntaxError(err))
 		}
 		return res, nil
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertSlice(i, func(val interface{}) (bool, error) { return c.ToBoolE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]bool]())
 	}
 }
 
@@ -239,7 +313,12 @@ func ToSliceBoolE(value interface{}, converters ...SliceBoolConverter) ([]bool,
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion fails, it returns an empty slice.
 func ToSliceInt(value interface{}, converters ...SliceIntConverter) []int {
-	res, _ := ToSliceIntE(value, converters...)
+	return std.ToSliceInt(value, converters...)
+}
+
+// ToSliceInt is like the package-level ToSliceInt but uses the options of c.
+func (c *Converter) ToSliceInt(value interface{}, converters ...SliceIntConverter) []int {
+	res, _ := c.ToSliceIntE(value, converters...)
 	return res
 }
 
@@ -247,10 +326,15 @@ func ToSliceInt(value interface{}, converters ...SliceIntConverter) []int {
 // It takes a value of any type, a default value of type []int, and a variable number of custom converters.
 // If the input value is nil or if the conversion fails, it returns the default value.
 func ToSliceIntOrDefault(value interface{}, defaultValue []int, converters ...SliceIntConverter) []int {
+	return std.ToSliceIntOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceIntOrDefault is like the package-level ToSliceIntOrDefault but uses the options of c.
+func (c *Converter) ToSliceIntOrDefault(value interface{}, defaultValue []int, converters ...SliceIntConverter) []int {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToSliceIntE(value, converters...)
+	res, _ := c.ToSliceIntE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -262,11 +346,19 @@ func ToSliceIntOrDefault(value interface{}, defaultValue []int, converters ...Sl
 // If the conversion succeeds, it returns the resulting []int and a nil error.
 // If the conversion fails, it returns nil and an error describing the problem.
 func ToSliceIntE(value interface{}, converters ...SliceIntConverter) ([]int, error) {
+	return std.ToSliceIntE(value, converters...)
+}
+
+// ToSliceIntE is like the package-level ToSliceIntE but uses the options of c.
+func (c *Converter) ToSliceIntE(value interface{}, converters ...SliceIntConverter) ([]int, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[[]int]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -274,15 +366,19 @@ func ToSliceIntE(value interface{}, converters ...SliceIntConverter) ([]int, err
 		}
 	}
 
+	if res, ok, err := fromRegistry[[]int](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case []int:
 		return v, nil
 	case []interface{}:
 		res := make([]int, len(v))
 		for i, val := range v {
-			intVal, err := ToIntE(val)
+			intVal, err := c.ToIntE(val)
 			if err != nil {
-				return nil, fmt.Errorf("unable to convert element %d to int: %v", i, err)
+				return nil, pathError(err, indexPath(i), val, reflect.TypeFor[int]())
 			}
 			res[i] = intVal
 		}
@@ -291,18 +387,21 @@ func ToSliceIntE(value interface{}, converters ...SliceIntConverter) ([]int, err
 		var res []int
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]int](), syntaxError(err))
 		}
 		return res, nil
 	case []byte:
 		var res []int
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]int](), syntaxError(err))
 		}
 		return res, nil
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertSlice(i, func(val interface{}) (int, error) { return c.ToIntE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]int]())
 	}
 }
 
@@ -310,7 +409,12 @@ func ToSliceIntE(value interface{}, converters ...SliceIntConverter) ([]int, err
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion fails, it returns an empty slice.
 func ToSliceTime(value interface{}, converters ...SliceTimeConverter) []time.Time {
-	res, _ := ToSliceTimeE(value, converters...)
+	return std.ToSliceTime(value, converters...)
+}
+
+// ToSliceTime is like the package-level ToSliceTime but uses the options of c.
+func (c *Converter) ToSliceTime(value interface{}, converters ...SliceTimeConverter) []time.Time {
+	res, _ := c.ToSliceTimeE(value, converters...)
 	return res
 }
 
@@ -318,10 +422,15 @@ func ToSliceTime(value interface{}, converters ...SliceTimeConverter) []time.Tim
 // It takes a value of any type, a default value of type []time.Time, and a variable number of custom converters.
 // If the input value is nil or if the conversion fails, it returns the default value.
 func ToSliceTimeOrDefault(value interface{}, defaultValue []time.Time, converters ...SliceTimeConverter) []time.Time {
+	return std.ToSliceTimeOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceTimeOrDefault is like the package-level ToSliceTimeOrDefault but uses the options of c.
+func (c *Converter) ToSliceTimeOrDefault(value interface{}, defaultValue []time.Time, converters ...SliceTimeConverter) []time.Time {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToSliceTimeE(value, converters...)
+	res, _ := c.ToSliceTimeE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -333,11 +442,19 @@ func ToSliceTimeOrDefault(value interface{}, defaultValue []time.Time, converter
 // If the conversion succeeds, it returns the resulting []time.Time and a nil error.
 // If the conversion fails, it returns nil and an error describing the problem.
 func ToSliceTimeE(value interface{}, converters ...SliceTimeConverter) ([]time.Time, error) {
+	return std.ToSliceTimeE(value, converters...)
+}
+
+// ToSliceTimeE is like the package-level ToSliceTimeE but uses the options of c.
+func (c *Converter) ToSliceTimeE(value interface{}, converters ...SliceTimeConverter) ([]time.Time, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[[]time.Time]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -345,15 +462,19 @@ func ToSliceTimeE(value interface{}, converters ...SliceTimeConverter) ([]time.T
 		}
 	}
 
+	if res, ok, err := fromRegistry[[]time.Time](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case []time.Time:
 		return v, nil
 	case []interface{}:
 		res := make([]time.Time, len(v))
 		for i, val := range v {
-			timeVal, err := ToTimeE(val)
+			timeVal, err := c.ToTimeE(val)
 			if err != nil {
-				return nil, fmt.Errorf("unable to convert element %d to time.Time: %v", i, err)
+				return nil, pathError(err, indexPath(i), val, reflect.TypeFor[time.Time]())
 			}
 			res[i] = timeVal
 		}
@@ -362,18 +483,21 @@ func ToSliceTimeE(value interface{}, converters ...SliceTimeConverter) ([]time.T
 		var res []time.Time
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]time.Time](), syntaxError(err))
 		}
 		return res, nil
 	case []byte:
 		var res []time.Time
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]time.Time](), syntaxError(err))
 		}
 		return res, nil
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertSlice(i, func(val interface{}) (time.Time, error) { return c.ToTimeE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]time.Time]())
 	}
 }
 
@@ -381,7 +505,12 @@ func ToSliceTimeE(value interface{}, converters ...SliceTimeConverter) ([]time.T
 // It takes a value of any type and a variable number of custom converters.
 // If the conversion fails, it returns an empty slice.
 func ToSliceDuration(value interface{}, converters ...SliceDurationConverter) []time.Duration {
-	res, _ := ToSliceDurationE(value, converters...)
+	return std.ToSliceDuration(value, converters...)
+}
+
+// ToSliceDuration is like the package-level ToSliceDuration but uses the options of c.
+func (c *Converter) ToSliceDuration(value interface{}, converters ...SliceDurationConverter) []time.Duration {
+	res, _ := c.ToSliceDurationE(value, converters...)
 	return res
 }
 
@@ -389,10 +518,15 @@ func ToSliceDuration(value interface{}, converters ...SliceDurationConverter) []
 // It takes a value of any type, a default value of type []time.Duration, and a variable number of custom converters.
 // If the input value is nil or if the conversion fails, it returns the default value.
 func ToSliceDurationOrDefault(value interface{}, defaultValue []time.Duration, converters ...SliceDurationConverter) []time.Duration {
+	return std.ToSliceDurationOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceDurationOrDefault is like the package-level ToSliceDurationOrDefault but uses the options of c.
+func (c *Converter) ToSliceDurationOrDefault(value interface{}, defaultValue []time.Duration, converters ...SliceDurationConverter) []time.Duration {
 	if value == nil {
 		return defaultValue
 	}
-	res, _ := ToSliceDurationE(value, converters...)
+	res, _ := c.ToSliceDurationE(value, converters...)
 	if res == nil {
 		return defaultValue
 	}
@@ -404,11 +538,19 @@ func ToSliceDurationOrDefault(value interface{}, defaultValue []time.Duration, c
 // If the conversion succeeds, it returns the resulting []time.Duration and a nil error.
 // If the conversion fails, it returns nil and an error describing the problem.
 func ToSliceDurationE(value interface{}, converters ...SliceDurationConverter) ([]time.Duration, error) {
+	return std.ToSliceDurationE(value, converters...)
+}
+
+// ToSliceDurationE is like the package-level ToSliceDurationE but uses the options of c.
+func (c *Converter) ToSliceDurationE(value interface{}, converters ...SliceDurationConverter) ([]time.Duration, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	i := Indirect(value)
+	i, err := indirectValue(value, reflect.TypeFor[[]time.Duration]())
+	if err != nil {
+		return nil, err
+	}
 
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
@@ -416,15 +558,19 @@ func ToSliceDurationE(value interface{}, converters ...SliceDurationConverter) (
 		}
 	}
 
+	if res, ok, err := fromRegistry[[]time.Duration](value); ok {
+		return res, err
+	}
+
 	switch v := i.(type) {
 	case []time.Duration:
 		return v, nil
 	case []interface{}:
 		res := make([]time.Duration, len(v))
 		for i, val := range v {
-			durationVal, err := ToDurationE(val)
+			durationVal, err := c.ToDurationE(val)
 			if err != nil {
-				return nil, fmt.Errorf("unable to convert element %d to time.Duration: %v", i, err)
+				return nil, pathError(err, indexPath(i), val, reflect.TypeFor[time.Duration]())
 			}
 			res[i] = durationVal
 		}
@@ -433,17 +579,135 @@ func ToSliceDurationE(value interface{}, converters ...SliceDurationConverter) (
 		var res []time.Duration
 		err := json.Unmarshal([]byte(v), &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]time.Duration](), syntaxError(err))
 		}
 		return res, nil
 	case []byte:
 		var res []time.Duration
 		err := json.Unmarshal(v, &res)
 		if err != nil {
-			return nil, err
+			return nil, newError(v, reflect.TypeFor[[]time.Duration](), syntaxError(err))
 		}
 		return res, nil
 	default:
-		return nil, fmt.Errorf("unsupported type: %T", value)
+		if res, ok, err := convertSlice(i, func(val interface{}) (time.Duration, error) { return c.ToDurationE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]time.Duration]())
+	}
+}
+
+// ToSliceByteSize converts any type of value to []ByteSize.
+// It takes a value of any type and a variable number of custom converters.
+// If the conversion fails, it returns an empty slice.
+func ToSliceByteSize(value interface{}, converters ...SliceByteSizeConverter) []ByteSize {
+	return std.ToSliceByteSize(value, converters...)
+}
+
+// ToSliceByteSize is like the package-level ToSliceByteSize but uses the options of c.
+func (c *Converter) ToSliceByteSize(value interface{}, converters ...SliceByteSizeConverter) []ByteSize {
+	res, _ := c.ToSliceByteSizeE(value, converters...)
+	return res
+}
+
+// ToSliceByteSizeOrDefault converts any type of value to []ByteSize or returns a default value.
+// It takes a value of any type, a default value of type []ByteSize, and a variable number of custom converters.
+// If the input value is nil or if the conversion fails, it returns the default value.
+func ToSliceByteSizeOrDefault(value interface{}, defaultValue []ByteSize, converters ...SliceByteSizeConverter) []ByteSize {
+	return std.ToSliceByteSizeOrDefault(value, defaultValue, converters...)
+}
+
+// ToSliceByteSizeOrDefault is like the package-level ToSliceByteSizeOrDefault but uses the options of c.
+func (c *Converter) ToSliceByteSizeOrDefault(value interface{}, defaultValue []ByteSize, converters ...SliceByteSizeConverter) []ByteSize {
+	if value == nil {
+		return defaultValue
+	}
+	res, _ := c.ToSliceByteSizeE(value, converters...)
+	if res == nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToSliceByteSizeE converts any type of value to []ByteSize.
+// It takes a value of any type and a variable number of custom converters.
+// If the conversion succeeds, it returns the resulting []ByteSize and a nil error.
+// If the conversion fails, it returns nil and an error describing the problem.
+func ToSliceByteSizeE(value interface{}, converters ...SliceByteSizeConverter) ([]ByteSize, error) {
+	return std.ToSliceByteSizeE(value, converters...)
+}
+
+// ToSliceByteSizeE is like the package-level ToSliceByteSizeE but uses the options of c.
+func (c *Converter) ToSliceByteSizeE(value interface{}, converters ...SliceByteSizeConverter) ([]ByteSize, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[[]ByteSize]())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, converter := range converters {
+		if result := converter(value); result != nil {
+			return *result, nil
+		}
+	}
+
+	if res, ok, err := fromRegistry[[]ByteSize](value); ok {
+		return res, err
+	}
+
+	switch v := i.(type) {
+	case []ByteSize:
+		return v, nil
+	case []interface{}:
+		res := make([]ByteSize, len(v))
+		for i, val := range v {
+			sizeVal, err := c.ToByteSizeE(val)
+			if err != nil {
+				return nil, pathError(err, indexPath(i), val, byteSizeType)
+			}
+			res[i] = sizeVal
+		}
+		return res, nil
+	case string:
+		var res []ByteSize
+		err := json.Unmarshal([]byte(v), &res)
+		if err != nil {
+			return nil, newError(v, reflect.TypeFor[[]ByteSize](), syntaxError(err))
+		}
+		return res, nil
+	case []byte:
+		var res []ByteSize
+		err := json.Unmarshal(v, &res)
+		if err != nil {
+			return nil, newError(v, reflect.TypeFor[[]ByteSize](), syntaxError(err))
+		}
+		return res, nil
+	default:
+		if res, ok, err := convertSlice(i, func(val interface{}) (ByteSize, error) { return c.ToByteSizeE(val) }); ok {
+			return res, err
+		}
+		return nil, unsupportedError(value, reflect.TypeFor[[]ByteSize]())
+	}
+}
+
+// convertSlice converts every element of a slice or an array with the given function.
+// The boolean result reports whether value is a slice or an array.
+func convertSlice[T any](value interface{}, convert func(interface{}) (T, error)) ([]T, bool, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false, nil
+	}
+
+	res := make([]T, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem, err := convert(v.Index(i).Interface())
+		if err != nil {
+			return nil, true, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeFor[T]())
+		}
+		res[i] = elem
 	}
+	return res, true, nil
 }
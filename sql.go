@@ -0,0 +1,153 @@
+package convert
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+var (
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// indirectValue dereferences value like Indirect and unwraps driver.Valuer values,
+// so that sql.NullInt64{Int64: 1, Valid: true} converts like int64(1)
+// and an invalid sql.NullInt64 like nil. The target type is used in errors.
+func indirectValue(value interface{}, to reflect.Type) (interface{}, error) {
+	i := Indirect(value)
+
+	vr, ok := driverValuer(value)
+	if !ok {
+		vr, ok = driverValuer(i)
+	}
+	if !ok {
+		return i, nil
+	}
+
+	v, err := vr.Value()
+	if err != nil {
+		return nil, newError(value, to, err)
+	}
+	return Indirect(v), nil
+}
+
+// driverValuer returns the driver.Valuer implemented by value, unless value is a nil pointer.
+func driverValuer(value interface{}) (driver.Valuer, bool) {
+	vr, ok := value.(driver.Valuer)
+	if !ok {
+		return nil, false
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, false
+	}
+	return vr, true
+}
+
+// isNullValuer reports whether value is a driver.Valuer holding a null, like an invalid sql.NullString.
+func isNullValuer(value interface{}) bool {
+	vr, ok := driverValuer(Indirect(value))
+	if !ok {
+		return false
+	}
+	v, err := vr.Value()
+	return err == nil && v == nil
+}
+
+// ToDriverValue converts any type of value to a driver.Value, ignoring errors.
+func ToDriverValue(value interface{}) driver.Value {
+	return std.ToDriverValue(value)
+}
+
+// ToDriverValue is like the package-level ToDriverValue but uses the options of c.
+func (c *Converter) ToDriverValue(value interface{}) driver.Value {
+	res, _ := c.ToDriverValueE(value)
+	return res
+}
+
+// ToDriverValueOrDefault converts any type of value to a driver.Value or returns the provided default value if conversion fails.
+func ToDriverValueOrDefault(value interface{}, defaultValue driver.Value) driver.Value {
+	return std.ToDriverValueOrDefault(value, defaultValue)
+}
+
+// ToDriverValueOrDefault is like the package-level ToDriverValueOrDefault but uses the options of c.
+func (c *Converter) ToDriverValueOrDefault(value interface{}, defaultValue driver.Value) driver.Value {
+	if value == nil {
+		return defaultValue
+	}
+	res, err := c.ToDriverValueE(value)
+	if err != nil {
+		return defaultValue
+	}
+	return res
+}
+
+// ToDriverValueE converts any type of value to a driver.Value or returns an error.
+// The result is one of the types accepted by database/sql drivers:
+// nil, int64, float64, bool, []byte, string or time.Time.
+//   - driver.Valuer values are unwrapped, invalid sql.Null* values giving nil
+//   - nil pointers give nil, other pointers are dereferenced
+//   - integer types give int64 (uint64 values above math.MaxInt64 are an ErrOverflow)
+//   - float types give float64
+//   - defined types with a basic underlying type give the driver type of that type
+//   - encoding.TextMarshaler values give their text form
+//
+// Other types are reported as ErrUnsupported.
+func ToDriverValueE(value interface{}) (driver.Value, error) {
+	return std.ToDriverValueE(value)
+}
+
+// ToDriverValueE is like the package-level ToDriverValueE but uses the options of c.
+func (c *Converter) ToDriverValueE(value interface{}) (driver.Value, error) {
+	to := reflect.TypeOf((*driver.Value)(nil)).Elem()
+
+	i, err := indirectValue(value, to)
+	if err != nil {
+		return nil, err
+	}
+	if rv := reflect.ValueOf(i); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, nil
+	}
+
+	switch v := basicValue(i).(type) {
+	case nil, int64, float64, bool, []byte, string, time.Time:
+		return v, nil
+	case int, int8, int16, int32, uint, uint8, uint16, uint32, uint64, uintptr:
+		return c.ToInt64E(v)
+	case float32:
+		return float64(v), nil
+	}
+
+	if m, ok := textMarshaler(i); ok {
+		b, err := m.MarshalText()
+		if err != nil {
+			return nil, newError(value, to, err)
+		}
+		return string(b), nil
+	}
+
+	if rv := reflect.ValueOf(i); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return rv.Bytes(), nil
+	}
+
+	return nil, unsupportedError(value, to)
+}
+
+// isScanner reports whether values of type t can be filled with sql.Scanner.
+func isScanner(t reflect.Type) bool {
+	return t.Kind() != reflect.Interface && t.Kind() != reflect.Pointer && reflect.PointerTo(t).Implements(scannerType)
+}
+
+// scan converts value to a driver.Value and fills a new value of type to with its Scan method.
+func (c *Converter) scan(value interface{}, to reflect.Type) (reflect.Value, error) {
+	v, err := c.ToDriverValueE(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+	ptr := reflect.New(to)
+	if err := ptr.Interface().(sql.Scanner).Scan(v); err != nil {
+		return InvalidValue, newError(value, to, syntaxError(err))
+	}
+	return ptr.Elem(), nil
+}
@@ -0,0 +1,217 @@
+package convert
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver is an in-memory database/sql driver whose queries all return fakeRows.
+type fakeDriver struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct{}
+
+type fakeRows struct {
+	pos int
+}
+
+var fakeRowsData = [][]driver.Value{
+	{int64(1), "42", 3.5, true, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+	{nil, nil, nil, nil, nil},
+}
+
+func init() {
+	sql.Register("convert-fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error)         { return fakeConn{}, nil }
+func (fakeConn) Prepare(string) (driver.Stmt, error)        { return fakeStmt{}, nil }
+func (fakeConn) Close() error                               { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                  { return nil, errors.New("not supported") }
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return nil, errors.New("not supported") }
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error)  { return &fakeRows{}, nil }
+func (*fakeRows) Columns() []string                         { return []string{"id", "code", "score", "active", "seen"} }
+func (*fakeRows) Close() error                              { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(fakeRowsData) {
+		return io.EOF
+	}
+	copy(dest, fakeRowsData[r.pos])
+	r.pos++
+	return nil
+}
+
+// failingValuer is a driver.Valuer whose Value method always fails.
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("no value")
+}
+
+type fakeRow struct {
+	id     sql.NullInt64
+	code   sql.NullString
+	score  sql.NullFloat64
+	active sql.NullBool
+	seen   sql.NullTime
+}
+
+func queryFakeRows(t *testing.T) []fakeRow {
+	db, err := sql.Open("convert-fake", "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT id, code, score, active, seen FROM things")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer rows.Close()
+
+	var res []fakeRow
+	for rows.Next() {
+		var r fakeRow
+		assert.NoError(t, rows.Scan(&r.id, &r.code, &r.score, &r.active, &r.seen))
+		res = append(res, r)
+	}
+	assert.NoError(t, rows.Err())
+	return res
+}
+
+func TestValuerInputs(t *testing.T) {
+	rows := queryFakeRows(t)
+	if !assert.Len(t, rows, 2) {
+		return
+	}
+
+	valid := rows[0]
+	assert.Equal(t, 1, ToInt(valid.id))
+	assert.Equal(t, uint8(1), ToUint8(&valid.id))
+	assert.Equal(t, 42, ToInt(valid.code))
+	assert.Equal(t, "3.5", ToString(valid.score))
+	assert.Equal(t, float32(3.5), ToFloat32(valid.score))
+	assert.Equal(t, true, ToBool(valid.active))
+	assert.Equal(t, "1", ToString(valid.id))
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ToTime(valid.seen))
+
+	null := rows[1]
+	assert.Equal(t, 0, ToInt(null.id))
+	assert.Equal(t, "", ToString(null.code))
+	assert.Equal(t, false, ToBool(null.active))
+	assert.True(t, ToTime(null.seen).IsZero())
+	assert.Equal(t, 7, ToIntOrDefault(failingValuer{}, 7))
+
+	_, err := Strict().ToStringE(null.code)
+	assert.ErrorIs(t, err, ErrNil)
+	_, err = ToSliceIntE(null.id)
+	assert.ErrorIs(t, err, ErrNil)
+
+	m, err := ToMapStringIntE(map[string]interface{}{"id": valid.id, "code": valid.code})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"id": 1, "code": 42}, m)
+
+	s, err := ToSliceStringE([]interface{}{valid.id, valid.active})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "true"}, s)
+
+	_, err = ToIntE(failingValuer{})
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, reflect.TypeFor[int](), convErr.To)
+		assert.EqualError(t, convErr.Err, "no value")
+	}
+}
+
+func TestToDriverValue(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		input    interface{}
+		expected driver.Value
+		err      error
+	}{
+		{nil, nil, nil},
+		{42, int64(42), nil},
+		{uint16(7), int64(7), nil},
+		{uint64(1 << 63), nil, ErrOverflow},
+		{float32(1.5), float64(1.5), nil},
+		{"text", "text", nil},
+		{[]byte("raw"), []byte("raw"), nil},
+		{true, true, nil},
+		{now, now, nil},
+		{namedPort(8080), int64(8080), nil},
+		{namedStatus("on"), "on", nil},
+		{ToPtr(3), int64(3), nil},
+		{(*int)(nil), nil, nil},
+		{sql.NullInt64{Int64: 5, Valid: true}, int64(5), nil},
+		{sql.NullString{}, nil, nil},
+		{textID{9}, "id-9", nil},
+		{failingValuer{}, nil, errors.New("no value")},
+		{[]int{1}, nil, ErrUnsupported},
+	}
+
+	for _, test := range tests {
+		res, err := ToDriverValueE(test.input)
+		if test.err != nil {
+			if assert.Error(t, err, "input %#v", test.input) && !errors.Is(err, test.err) {
+				assert.ErrorContains(t, err, test.err.Error())
+			}
+			continue
+		}
+		assert.NoError(t, err, "input %#v", test.input)
+		assert.Equal(t, test.expected, res, "input %#v", test.input)
+	}
+
+	assert.Equal(t, "fallback", ToDriverValueOrDefault([]int{1}, "fallback"))
+	assert.Equal(t, int64(3), ToDriverValue(int8(3)))
+}
+
+func TestToValueScanner(t *testing.T) {
+	v, err := ToValueE("12", reflect.TypeOf(sql.NullInt64{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, sql.NullInt64{Int64: 12, Valid: true}, v.Interface())
+	}
+
+	v, err = ToValueE(nil, reflect.TypeOf(sql.NullString{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, sql.NullString{}, v.Interface())
+	}
+
+	v, err = ToValueE(namedPort(80), reflect.TypeOf(sql.Null[int32]{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, sql.Null[int32]{V: 80, Valid: true}, v.Interface())
+	}
+
+	_, err = ToValueE("abc", reflect.TypeOf(sql.NullInt64{}))
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	n, err := ToE[sql.NullFloat64](2.5)
+	assert.NoError(t, err)
+	assert.Equal(t, sql.NullFloat64{Float64: 2.5, Valid: true}, n)
+
+	var dst struct {
+		Name sql.NullString `convert:"name"`
+		Age  sql.NullInt16  `convert:"age"`
+	}
+	err = Decode(map[string]interface{}{"name": "Ada", "age": "36"}, &dst)
+	assert.NoError(t, err)
+	assert.Equal(t, sql.NullString{String: "Ada", Valid: true}, dst.Name)
+	assert.Equal(t, sql.NullInt16{Int16: 36, Valid: true}, dst.Age)
+
+	got, err := ToMapStringInterfaceE(struct {
+		Name sql.NullString `convert:"name"`
+	}{Name: dst.Name})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": dst.Name}, got)
+}
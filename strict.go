@@ -0,0 +1,164 @@
+package convert
+
+import (
+	"math"
+	"math/bits"
+	"reflect"
+	"strconv"
+)
+
+// strictInteger reports, in strict mode, why the value cannot be converted to the integer type to.
+// It rejects nil, booleans, empty strings, strings that are not integers,
+// floats with a fractional part and values without an exact string form.
+// Range checks are left to the converters.
+func (c *Converter) strictInteger(value interface{}, to reflect.Type) error {
+	if !c.opts.strict {
+		return nil
+	}
+
+	switch n := value.(type) {
+	case nil:
+		return newError(value, to, ErrNil)
+	case bool:
+		return newError(value, to, ErrUnsupported)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+		return nil
+	case float32, float64:
+		if f := reflect.ValueOf(n).Float(); f != math.Trunc(f) {
+			return newError(value, to, ErrPrecisionLoss)
+		}
+		return nil
+	case string:
+		if n == "" {
+			return newError(value, to, ErrSyntax)
+		}
+		if _, err := c.parseInt(n); err == nil {
+			return nil
+		}
+		if _, err := c.parseUint(n); err == nil {
+			return nil
+		}
+		if c.opts.integerBase != 0 {
+			return c.radixError(value, n, to)
+		}
+		if f, err := strconv.ParseFloat(n, 64); err == nil {
+			if f != math.Trunc(f) {
+				return newError(value, to, ErrPrecisionLoss)
+			}
+			return nil
+		}
+		return newError(value, to, ErrSyntax)
+	case []byte:
+		return c.strictInteger(string(n), to)
+	default:
+		if _, err := c.ToStringE(n); err != nil {
+			return newError(value, to, ErrUnsupported)
+		}
+		return nil
+	}
+}
+
+// strictFloat reports, in strict mode, why the value cannot be converted to the float type to.
+// It rejects nil, booleans, empty strings, strings that are not numbers,
+// integers and floats that the target type cannot represent exactly,
+// and values without an exact string form.
+func (c *Converter) strictFloat(value interface{}, to reflect.Type) error {
+	if !c.opts.strict {
+		return nil
+	}
+
+	mantissa := 53
+	if to.Kind() == reflect.Float32 {
+		mantissa = 24
+	}
+
+	switch n := value.(type) {
+	case nil:
+		return newError(value, to, ErrNil)
+	case bool:
+		return newError(value, to, ErrUnsupported)
+	case int, int8, int16, int32, int64:
+		i := reflect.ValueOf(n).Int()
+		abs := uint64(i)
+		if i < 0 {
+			abs = -abs
+		}
+		if !exactMantissa(abs, mantissa) {
+			return newError(value, to, ErrPrecisionLoss)
+		}
+		return nil
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		if !exactMantissa(reflect.ValueOf(n).Uint(), mantissa) {
+			return newError(value, to, ErrPrecisionLoss)
+		}
+		return nil
+	case float32:
+		return nil
+	case float64:
+		if mantissa == 24 && !math.IsNaN(n) && float64(float32(n)) != n {
+			return newError(value, to, ErrPrecisionLoss)
+		}
+		return nil
+	case string:
+		if n == "" {
+			return newError(value, to, ErrSyntax)
+		}
+		if _, err := strconv.ParseFloat(n, 64); err != nil {
+			return newError(value, to, ErrSyntax)
+		}
+		return nil
+	case []byte:
+		return c.strictFloat(string(n), to)
+	default:
+		if _, err := c.ToStringE(n); err != nil {
+			return newError(value, to, ErrUnsupported)
+		}
+		return nil
+	}
+}
+
+// strictBool reports, in strict mode, why the value cannot be converted to bool.
+// Only booleans and strings are accepted.
+func (c *Converter) strictBool(value interface{}) error {
+	if !c.opts.strict {
+		return nil
+	}
+
+	switch value.(type) {
+	case bool, string, []byte:
+		return nil
+	case nil:
+		return newError(value, reflect.TypeFor[bool](), ErrNil)
+	default:
+		return newError(value, reflect.TypeFor[bool](), ErrUnsupported)
+	}
+}
+
+// exactMantissa reports whether n can be represented exactly with the given number of mantissa bits.
+func exactMantissa(n uint64, mantissa int) bool {
+	if n == 0 {
+		return true
+	}
+	return bits.Len64(n)-bits.TrailingZeros64(n) <= mantissa
+}
+
+// strictNumber reports, in strict mode, why the value cannot be converted to the
+// arbitrary-precision or complex type to. It rejects nil, booleans and empty strings;
+// syntax and precision are checked by the converters.
+func (c *Converter) strictNumber(value interface{}, to reflect.Type) error {
+	if !c.opts.strict {
+		return nil
+	}
+
+	switch n := value.(type) {
+	case nil:
+		return newError(value, to, ErrNil)
+	case bool:
+		return newError(value, to, ErrUnsupported)
+	case string:
+		if n == "" {
+			return newError(value, to, ErrSyntax)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package convert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictIntegers(t *testing.T) {
+	strict := Strict()
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    int
+		wantErr error
+	}{
+		{"int", 42, 42, nil},
+		{"uint", uint8(7), 7, nil},
+		{"integral float", 3.0, 3, nil},
+		{"integer string", "42", 42, nil},
+		{"hex string", "0x10", 16, nil},
+		{"integral float string", "3.0", 3, nil},
+		{"bytes", []byte("12"), 12, nil},
+		{"fractional float", 3.9, 0, ErrPrecisionLoss},
+		{"fractional float string", "3.9", 0, ErrPrecisionLoss},
+		{"empty string", "", 0, ErrSyntax},
+		{"bool string", "true", 0, ErrSyntax},
+		{"bool", true, 0, ErrUnsupported},
+		{"nil", nil, 0, ErrNil},
+		{"struct", struct{ A int }{1}, 0, ErrUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := strict.ToIntE(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStrictFloats(t *testing.T) {
+	strict := Strict()
+
+	tests := []struct {
+		name    string
+		convert func() error
+		wantErr error
+	}{
+		{"float64 from int", func() error { _, err := strict.ToFloat64E(1 << 53); return err }, nil},
+		{"float64 from large int", func() error { _, err := strict.ToFloat64E(1<<53 + 1); return err }, ErrPrecisionLoss},
+		{"float32 from int", func() error { _, err := strict.ToFloat32E(1 << 24); return err }, nil},
+		{"float32 from large int", func() error { _, err := strict.ToFloat32E(1<<24 + 1); return err }, ErrPrecisionLoss},
+		{"float32 from exact float64", func() error { _, err := strict.ToFloat32E(0.5); return err }, nil},
+		{"float32 from inexact float64", func() error { _, err := strict.ToFloat32E(0.1); return err }, ErrPrecisionLoss},
+		{"float64 from string", func() error { _, err := strict.ToFloat64E("0.1"); return err }, nil},
+		{"float64 from bool", func() error { _, err := strict.ToFloat64E(true); return err }, ErrUnsupported},
+		{"float32 from bool", func() error { _, err := strict.ToFloat32E(false); return err }, ErrUnsupported},
+		{"float64 from bool string", func() error { _, err := strict.ToFloat64E("true"); return err }, ErrSyntax},
+		{"float64 from empty string", func() error { _, err := strict.ToFloat64E(""); return err }, ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.convert()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestStrictBool(t *testing.T) {
+	strict := Strict()
+
+	b, err := strict.ToBoolE("yes")
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	_, err = strict.ToBoolE(1)
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	_, err = strict.ToBoolE(nil)
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func TestStrictString(t *testing.T) {
+	strict := Strict()
+
+	s, err := strict.ToStringE(3.5)
+	assert.NoError(t, err)
+	assert.Equal(t, "3.5", s)
+
+	s, err = strict.ToStringE(errors.New("boom"))
+	assert.NoError(t, err)
+	assert.Equal(t, "boom", s)
+
+	_, err = strict.ToStringE([]int{1, 2, 3})
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	_, err = strict.ToStringE(nil)
+	assert.ErrorIs(t, err, ErrNil)
+}
+
+func TestStrictOption(t *testing.T) {
+	c := New(WithStrict(true), WithEmptyStringAsZero(true))
+	_, err := c.ToIntE("")
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	lenient := c.With(WithStrict(false))
+	n, err := lenient.ToIntE("3.9")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// The default Converter stays lenient.
+	assert.Equal(t, 1, ToInt("true"))
+	assert.Equal(t, float32(1), ToFloat32(true))
+
+	_, err = ToWithE[int](Strict(), "3.9")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = Strict().ToSliceIntE([]interface{}{1, 2.5})
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+}
@@ -49,20 +49,32 @@ type StringConvert func(value interface{}) *string
 //	dateStr := ToString(time.Now(), customConverter)
 //	fmt.Println(dateStr) // Output: "2023-04-15" (example date)
 func ToString(value interface{}, converters ...StringConvert) string {
-	s, _ := ToStringE(value, converters...)
+	return std.ToString(value, converters...)
+}
+
+// ToString is like the package-level ToString but uses the options of c.
+func (c *Converter) ToString(value interface{}, converters ...StringConvert) string {
+	s, _ := c.ToStringE(value, converters...)
 	return s
 }
 
 // ToStringE converts any type of value to string or returns an error.
-// It handles various types including:
+// Values implementing encoding.TextMarshaler, directly or through a pointer, are
+// converted with MarshalText; time.Time, for example, gives an RFC 3339 string.
+// It also handles various types including:
 //   - string, []byte, nil, bool
 //   - integer types (int, int8, int16, int32, int64)
 //   - unsigned integer types (uint, uint8, uint16, uint32, uint64)
 //   - float types (float32, float64)
+//   - complex types (complex64, complex128), formatted like "(3+4i)", which ToComplex128E parses back
 //   - fmt.Stringer, fmt.GoStringer, and error interfaces
+//   - defined types with a basic underlying type, like `type Status string`
 //
 // For other types, it uses fmt.Sprintf("%v", s).
 //
+// Integers, floats and Decimal values are formatted with the options WithFloatFormat,
+// WithNumberWidth, WithNumberGrouping and WithFormatLocale of the converter, if any.
+//
 // Parameters:
 //   - value: The value to be converted to string.
 //   - converters: Optional custom converters to be applied before the default conversion.
@@ -92,13 +104,48 @@ func ToString(value interface{}, converters ...StringConvert) string {
 //	}
 //	fmt.Println(arrStr) // Output: "[1 2 3]"
 func ToStringE(value interface{}, converters ...StringConvert) (string, error) {
+	return std.ToStringE(value, converters...)
+}
+
+// ToStringE is like the package-level ToStringE but uses the options of c.
+func (c *Converter) ToStringE(value interface{}, converters ...StringConvert) (string, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[string](value); ok {
+		return res, err
+	}
+
+	if s, ok := c.formatNumber(value); ok {
+		return s, nil
+	}
+
+	return c.plainString(value)
+}
+
+// plainString converts value to a string like ToStringE does, but without custom converters
+// and the number style of c. The converters use it for the strings they parse back, like
+// the text of the default branches, of UnmarshalText or of map keys.
+func (c *Converter) plainString(value interface{}) (string, error) {
+	if res, ok, err := fromRegistry[string](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[string]())
+	if err != nil {
+		return "", err
+	}
+
+	if m, ok := textMarshaler(i); ok {
+		b, err := m.MarshalText()
+		if err != nil {
+			return "", newError(value, reflect.TypeFor[string](), err)
+		}
+		return string(b), nil
+	}
 
 	switch s := i.(type) {
 	case string:
@@ -106,6 +153,9 @@ func ToStringE(value interface{}, converters ...StringConvert) (string, error) {
 	case []byte:
 		return string(s), nil
 	case nil:
+		if c.opts.strict {
+			return "", newError(value, reflect.TypeFor[string](), ErrNil)
+		}
 		return "", nil
 	case bool:
 		return strconv.FormatBool(s), nil
@@ -133,6 +183,10 @@ func ToStringE(value interface{}, converters ...StringConvert) (string, error) {
 		return strconv.FormatFloat(float64(s), 'f', -1, 32), nil
 	case float64:
 		return strconv.FormatFloat(s, 'f', -1, 64), nil
+	case complex64:
+		return strconv.FormatComplex(complex128(s), 'f', -1, 64), nil
+	case complex128:
+		return strconv.FormatComplex(s, 'f', -1, 128), nil
 	case fmt.Stringer:
 		return s.String(), nil
 	case fmt.GoStringer:
@@ -142,6 +196,12 @@ func ToStringE(value interface{}, converters ...StringConvert) (string, error) {
 			if e, ok := value.(error); ok {
 				return e.Error(), nil
 			}
+			if b := basicValue(s); reflect.TypeOf(b) != reflect.TypeOf(s) {
+				return c.plainString(b)
+			}
+			if c.opts.strict {
+				return "", unsupportedError(value, reflect.TypeFor[string]())
+			}
 			return fmt.Sprintf("%v", s), nil
 		}
 	}
@@ -177,7 +237,12 @@ func ToStringE(value interface{}, converters ...StringConvert) (string, error) {
 //	dateStr := ToStringOrDefault(time.Now(), "default", customConverter)
 //	fmt.Println(dateStr) // Output: "2023-04-15" (example date)
 func ToStringOrDefault(value interface{}, defaultValue string, converters ...StringConvert) string {
-	s, err := ToStringE(value, converters...)
+	return std.ToStringOrDefault(value, defaultValue, converters...)
+}
+
+// ToStringOrDefault is like the package-level ToStringOrDefault but uses the options of c.
+func (c *Converter) ToStringOrDefault(value interface{}, defaultValue string, converters ...StringConvert) string {
+	s, err := c.ToStringE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -210,7 +275,12 @@ func ToStringOrDefault(value interface{}, defaultValue string, converters ...Str
 //	dates := ToStringArray([]time.Time{time.Now(), time.Now().AddDate(0, 0, 1)}, customConverter)
 //	fmt.Println(dates) // Output: ["2023-04-15", "2023-04-16"] (example dates)
 func ToStringArray(value interface{}, converters ...StringConvert) []string {
-	result, _ := ToStringArrayE(value, converters...)
+	return std.ToStringArray(value, converters...)
+}
+
+// ToStringArray is like the package-level ToStringArray but uses the options of c.
+func (c *Converter) ToStringArray(value interface{}, converters ...StringConvert) []string {
+	result, _ := c.ToStringArrayE(value, converters...)
 	return result
 }
 
@@ -244,7 +314,12 @@ func ToStringArray(value interface{}, converters ...StringConvert) []string {
 //	dates := ToStringArrayOrDefault([]time.Time{time.Now(), time.Now().AddDate(0, 0, 1)}, []string{"default"}, customConverter)
 //	fmt.Println(dates) // Output: ["2023-04-15", "2023-04-16"] (example dates)
 func ToStringArrayOrDefault(value interface{}, defaultValue []string, converters ...StringConvert) []string {
-	result, err := ToStringArrayE(value, converters...)
+	return std.ToStringArrayOrDefault(value, defaultValue, converters...)
+}
+
+// ToStringArrayOrDefault is like the package-level ToStringArrayOrDefault but uses the options of c.
+func (c *Converter) ToStringArrayOrDefault(value interface{}, defaultValue []string, converters ...StringConvert) []string {
+	result, err := c.ToStringArrayE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -283,24 +358,33 @@ func ToStringArrayOrDefault(value interface{}, defaultValue []string, converters
 //	}
 //	fmt.Println(customArr) // Output: ["custom1", "custom2"]
 func ToStringArrayE(value interface{}, converters ...StringConvert) ([]string, error) {
+	return std.ToStringArrayE(value, converters...)
+}
+
+// ToStringArrayE is like the package-level ToStringArrayE but uses the options of c.
+func (c *Converter) ToStringArrayE(value interface{}, converters ...StringConvert) ([]string, error) {
 	if value == nil {
 		return []string{}, nil
 	}
 
+	if res, ok, err := fromRegistry[[]string](value); ok {
+		return res, err
+	}
+
 	switch v := reflect.ValueOf(value); v.Kind() {
 	case reflect.Array, reflect.Slice:
 		length := v.Len()
 		result := make([]string, length)
 		for i := 0; i < length; i++ {
-			str, err := ToStringE(v.Index(i).Interface(), converters...)
+			str, err := c.ToStringE(v.Index(i).Interface(), converters...)
 			if err != nil {
-				return nil, fmt.Errorf("error converting element at index %d: %v", i, err)
+				return nil, pathError(err, indexPath(i), v.Index(i).Interface(), reflect.TypeFor[string]())
 			}
 			result[i] = str
 		}
 		return result, nil
 	default:
-		str, err := ToStringE(value, converters...)
+		str, err := c.ToStringE(value, converters...)
 		if err != nil {
 			return nil, err
 		}
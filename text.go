@@ -0,0 +1,54 @@
+package convert
+
+import (
+	"encoding"
+	"reflect"
+)
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// textMarshaler returns the encoding.TextMarshaler implemented by value,
+// by the values its pointers point to, or by a pointer to a copy of them.
+// Nil pointers are not returned.
+func textMarshaler(value interface{}) (encoding.TextMarshaler, bool) {
+	rv := reflect.ValueOf(value)
+	for rv.IsValid() {
+		if rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return nil, false
+		}
+		if rv.Type().Implements(textMarshalerType) {
+			return rv.Interface().(encoding.TextMarshaler), true
+		}
+		if rv.Kind() != reflect.Pointer {
+			if reflect.PointerTo(rv.Type()).Implements(textMarshalerType) {
+				ptr := reflect.New(rv.Type())
+				ptr.Elem().Set(rv)
+				return ptr.Interface().(encoding.TextMarshaler), true
+			}
+			return nil, false
+		}
+		rv = rv.Elem()
+	}
+	return nil, false
+}
+
+// isTextUnmarshaler reports whether values of type t can be filled with encoding.TextUnmarshaler.
+func isTextUnmarshaler(t reflect.Type) bool {
+	return t.Kind() != reflect.Interface && t.Kind() != reflect.Pointer && reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// unmarshalText converts value to string and fills a new value of type to with its UnmarshalText method.
+func (c *Converter) unmarshalText(value interface{}, to reflect.Type) (reflect.Value, error) {
+	s, err := c.plainString(value)
+	if err != nil {
+		return InvalidValue, err
+	}
+	ptr := reflect.New(to)
+	if err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+		return InvalidValue, newError(value, to, syntaxError(err))
+	}
+	return ptr.Elem(), nil
+}
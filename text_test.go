@@ -0,0 +1,127 @@
+package convert
+
+import (
+	"errors"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// textID is a struct identifier with a text form "id-<n>".
+type textID struct {
+	n int
+}
+
+func (id textID) MarshalText() ([]byte, error) {
+	return []byte("id-" + ToString(id.n)), nil
+}
+
+func (id *textID) UnmarshalText(b []byte) error {
+	s, ok := strings.CutPrefix(string(b), "id-")
+	if !ok {
+		return errors.New("missing id- prefix")
+	}
+	n, err := ToIntE(s)
+	if err != nil {
+		return err
+	}
+	id.n = n
+	return nil
+}
+
+// textLevel is a defined integer type with named text forms.
+type textLevel int
+
+func (l *textLevel) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func TestToStringTextMarshaler(t *testing.T) {
+	assert.Equal(t, "id-7", ToString(textID{7}))
+	assert.Equal(t, "id-7", ToString(&textID{7}))
+	assert.Equal(t, "123", ToString(big.NewInt(123)))
+	assert.Equal(t, "10.0.0.1", ToString(netip.MustParseAddr("10.0.0.1")))
+	assert.Equal(t, "192.168.1.1", ToString(net.ParseIP("192.168.1.1")))
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	assert.Equal(t, "2024-05-06T07:08:09Z", ToString(now))
+
+	s, err := ToStringE((*big.Int)(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "<nil>", s)
+}
+
+func TestToValueTextUnmarshaler(t *testing.T) {
+	v, err := ToValueE("id-42", reflect.TypeOf(textID{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, textID{42}, v.Interface())
+	}
+
+	v, err = ToValueE([]byte("10.0.0.1"), reflect.TypeOf(netip.Addr{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), v.Interface())
+	}
+
+	v, err = ToValueE(12345, reflect.TypeOf(&big.Int{}))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, big.NewInt(12345).Cmp(v.Interface().(*big.Int)))
+	}
+
+	_, err = ToValueE("42", reflect.TypeOf(textID{}))
+	assert.ErrorIs(t, err, ErrSyntax)
+
+	v, err = ToValueE("high", reflect.TypeOf(textLevel(0)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, textLevel(2), v.Interface())
+	}
+
+	v, err = ToValueE(1, reflect.TypeOf(textLevel(0)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, textLevel(1), v.Interface())
+	}
+}
+
+func TestGenericTextUnmarshaler(t *testing.T) {
+	addr, err := ToE[netip.Addr]("::1")
+	assert.NoError(t, err)
+	assert.Equal(t, netip.IPv6Loopback(), addr)
+
+	id, err := ToE[*textID]("id-3")
+	assert.NoError(t, err)
+	if assert.NotNil(t, id) {
+		assert.Equal(t, 3, id.n)
+	}
+
+	ids, err := ToE[[]textID]([]string{"id-1", "id-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []textID{{1}, {2}}, ids)
+
+	var dst struct {
+		Owner textID       `convert:"owner"`
+		Peers []netip.Addr `convert:"peers"`
+	}
+	err = Decode(map[string]interface{}{"owner": "id-9", "peers": []string{"10.0.0.1"}}, &dst)
+	assert.NoError(t, err)
+	assert.Equal(t, textID{9}, dst.Owner)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.1")}, dst.Peers)
+
+	err = Decode(map[string]interface{}{"owner": "9"}, &dst)
+	var convErr *ConversionError
+	if assert.ErrorAs(t, err, &convErr) {
+		assert.Equal(t, "Owner", convErr.Path)
+	}
+}
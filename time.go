@@ -2,11 +2,13 @@ package convert
 
 import (
 	"errors"
+	"reflect"
 	"time"
 
 	"github.com/dromara/carbon/v2"
 )
 
+// ErrEmptyString is the cause of the ConversionError returned when an empty string is converted to time.Time.
 var ErrEmptyString = errors.New("cannot convert empty string to time.Time")
 
 // TimeConverter is a function type that converts any value to a pointer to time.Time.
@@ -45,13 +47,23 @@ type DurationConverter func(value interface{}) *time.Duration
 
 // ToTime converts any type of value to time.Time, ignoring errors.
 func ToTime(value interface{}, converters ...TimeConverter) time.Time {
-	res, _ := ToTimeE(value, converters...)
+	return std.ToTime(value, converters...)
+}
+
+// ToTime is like the package-level ToTime but uses the options of c.
+func (c *Converter) ToTime(value interface{}, converters ...TimeConverter) time.Time {
+	res, _ := c.ToTimeE(value, converters...)
 	return res
 }
 
 // ToTimeOrDefault converts any type of value to time.Time or returns the provided default value if conversion fails.
 func ToTimeOrDefault(value interface{}, defaultValue time.Time, converters ...TimeConverter) time.Time {
-	res, err := ToTimeE(value, converters...)
+	return std.ToTimeOrDefault(value, defaultValue, converters...)
+}
+
+// ToTimeOrDefault is like the package-level ToTimeOrDefault but uses the options of c.
+func (c *Converter) ToTimeOrDefault(value interface{}, defaultValue time.Time, converters ...TimeConverter) time.Time {
+	res, err := c.ToTimeE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -59,14 +71,30 @@ func ToTimeOrDefault(value interface{}, defaultValue time.Time, converters ...Ti
 }
 
 // ToTimeE converts any type of value to time.Time or returns an error.
+// With WithUnixTime, numbers are read as Unix timestamps.
 func ToTimeE(value interface{}, converters ...TimeConverter) (time.Time, error) {
+	return std.ToTimeE(value, converters...)
+}
+
+// ToTimeE is like the package-level ToTimeE but uses the options of c.
+func (c *Converter) ToTimeE(value interface{}, converters ...TimeConverter) (time.Time, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[time.Time](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[time.Time]())
+	if err != nil {
+		return time.Time{}, err
+	}
+	if res, ok, err := c.unixTime(value, i); ok {
+		return res, err
+	}
 
 	switch t := i.(type) {
 	case time.Time:
@@ -75,28 +103,47 @@ func ToTimeE(value interface{}, converters ...TimeConverter) (time.Time, error)
 		return *t, nil
 	case string:
 		if t == "" {
-			return time.Time{}, ErrEmptyString
+			return time.Time{}, newError(t, reflect.TypeFor[time.Time](), ErrEmptyString)
 		}
-		c := carbon.Parse(t)
-		if c.Error != nil {
-			return time.Time{}, c.Error
+		for _, layout := range c.opts.timeLayouts {
+			if ct := carbon.ParseByLayout(t, layout, c.timezone()...); ct.Error == nil {
+				return ct.StdTime(), nil
+			}
+			if ct := carbon.ParseByFormat(t, layout, c.timezone()...); ct.Error == nil {
+				return ct.StdTime(), nil
+			}
 		}
-		return c.StdTime(), nil
+		ct := carbon.Parse(t, c.timezone()...)
+		if ct.Error != nil {
+			return time.Time{}, newError(t, reflect.TypeFor[time.Time](), syntaxError(ct.Error))
+		}
+		return ct.StdTime(), nil
 	default:
-		valueStr := ToString(t)
-		return ToTimeE(valueStr, converters...)
+		valueStr, _ := c.plainString(t)
+		res, err := c.ToTimeE(valueStr, converters...)
+		return res, stringFormError(err, value, reflect.TypeFor[time.Time]())
 	}
 }
 
 // ToLayoutTime converts any type of value to time.Time with a layout applied, ignoring errors.
 func ToLayoutTime(layout string, value interface{}, converters ...TimeConverter) time.Time {
-	res, _ := ToLayoutTimeE(layout, value, converters...)
+	return std.ToLayoutTime(layout, value, converters...)
+}
+
+// ToLayoutTime is like the package-level ToLayoutTime but uses the options of c.
+func (c *Converter) ToLayoutTime(layout string, value interface{}, converters ...TimeConverter) time.Time {
+	res, _ := c.ToLayoutTimeE(layout, value, converters...)
 	return res
 }
 
 // ToLayoutTimeOrDefault converts any type of value to time.Time with a layout applied or returns the provided default value if conversion fails.
 func ToLayoutTimeOrDefault(layout string, value interface{}, defaultValue time.Time, converters ...TimeConverter) time.Time {
-	res, err := ToLayoutTimeE(layout, value, converters...)
+	return std.ToLayoutTimeOrDefault(layout, value, defaultValue, converters...)
+}
+
+// ToLayoutTimeOrDefault is like the package-level ToLayoutTimeOrDefault but uses the options of c.
+func (c *Converter) ToLayoutTimeOrDefault(layout string, value interface{}, defaultValue time.Time, converters ...TimeConverter) time.Time {
+	res, err := c.ToLayoutTimeE(layout, value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -105,13 +152,25 @@ func ToLayoutTimeOrDefault(layout string, value interface{}, defaultValue time.T
 
 // ToLayoutTimeE converts any type of value to time.Time with a layout applied or returns an error.
 func ToLayoutTimeE(layout string, value interface{}, converters ...TimeConverter) (time.Time, error) {
+	return std.ToLayoutTimeE(layout, value, converters...)
+}
+
+// ToLayoutTimeE is like the package-level ToLayoutTimeE but uses the options of c.
+func (c *Converter) ToLayoutTimeE(layout string, value interface{}, converters ...TimeConverter) (time.Time, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[time.Time](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[time.Time]())
+	if err != nil {
+		return time.Time{}, err
+	}
 
 	switch t := i.(type) {
 	case time.Time:
@@ -120,56 +179,80 @@ func ToLayoutTimeE(layout string, value interface{}, converters ...TimeConverter
 		return *t, nil
 	case string:
 		if t == "" {
-			return time.Time{}, ErrEmptyString
+			return time.Time{}, newError(t, reflect.TypeFor[time.Time](), ErrEmptyString)
 		}
 		if layout == "" {
 			return time.Time{}, errors.New("layout cannot be empty")
 		}
-		c := carbon.ParseByFormat(t, layout)
-		if c.Error != nil {
-			c := carbon.ParseByLayout(t, layout)
-			if c.Error != nil {
-				return time.Time{}, c.Error
+		ct := carbon.ParseByFormat(t, layout, c.timezone()...)
+		if ct.Error != nil {
+			ct := carbon.ParseByLayout(t, layout, c.timezone()...)
+			if ct.Error != nil {
+				return time.Time{}, newError(t, reflect.TypeFor[time.Time](), syntaxError(ct.Error))
 			}
 		}
-		return c.StdTime(), nil
+		return ct.StdTime(), nil
 	default:
-		valueStr := ToString(t)
-		return ToLayoutTimeE(layout, valueStr, converters...)
+		valueStr, _ := c.plainString(t)
+		res, err := c.ToLayoutTimeE(layout, valueStr, converters...)
+		return res, stringFormError(err, value, reflect.TypeFor[time.Time]())
 	}
 }
 
 // ToTimeString converts a time.Time to a string with an optional format, ignoring errors.
 func ToTimeString(t time.Time, format ...string) string {
-	res, _ := ToTimeStringE(t, format...)
+	return std.ToTimeString(t, format...)
+}
+
+// ToTimeString is like the package-level ToTimeString but uses the options of c.
+func (c *Converter) ToTimeString(t time.Time, format ...string) string {
+	res, _ := c.ToTimeStringE(t, format...)
 	return res
 }
 
 // ToTimeStringE converts a time.Time to a string with an optional format or returns an error.
 func ToTimeStringE(t time.Time, format ...string) (string, error) {
-	c := carbon.CreateFromStdTime(t)
-	if c.Error != nil {
-		return "", c.Error
+	return std.ToTimeStringE(t, format...)
+}
+
+// ToTimeStringE is like the package-level ToTimeStringE but uses the options of c.
+func (c *Converter) ToTimeStringE(t time.Time, format ...string) (string, error) {
+	if c.opts.timeLocation != nil {
+		t = t.In(c.opts.timeLocation)
+	}
+	ct := carbon.CreateFromStdTime(t, c.timezone()...)
+	if ct.Error != nil {
+		return "", ct.Error
 	}
 	if len(format) > 0 {
 		r := t.Format(format[len(format)-1])
 		if r != format[len(format)-1] {
 			return r, nil
 		}
-		return c.Format(format[len(format)-1]), nil
+		return ct.Format(format[len(format)-1]), nil
 	}
-	return c.String(), nil
+	return ct.String(), nil
 }
 
 // ToDuration converts any type of value to time.Duration, ignoring errors.
 func ToDuration(value interface{}, converters ...DurationConverter) time.Duration {
-	res, _ := ToDurationE(value, converters...)
+	return std.ToDuration(value, converters...)
+}
+
+// ToDuration is like the package-level ToDuration but uses the options of c.
+func (c *Converter) ToDuration(value interface{}, converters ...DurationConverter) time.Duration {
+	res, _ := c.ToDurationE(value, converters...)
 	return res
 }
 
 // ToDurationOrDefault converts any type of value to time.Duration or returns the provided default value if conversion fails.
 func ToDurationOrDefault(value interface{}, defaultValue time.Duration, converters ...DurationConverter) time.Duration {
-	res, err := ToDurationE(value, converters...)
+	return std.ToDurationOrDefault(value, defaultValue, converters...)
+}
+
+// ToDurationOrDefault is like the package-level ToDurationOrDefault but uses the options of c.
+func (c *Converter) ToDurationOrDefault(value interface{}, defaultValue time.Duration, converters ...DurationConverter) time.Duration {
+	res, err := c.ToDurationE(value, converters...)
 	if err != nil {
 		return defaultValue
 	}
@@ -178,13 +261,25 @@ func ToDurationOrDefault(value interface{}, defaultValue time.Duration, converte
 
 // ToDurationE converts any type of value to time.Duration or returns an error.
 func ToDurationE(value interface{}, converters ...DurationConverter) (time.Duration, error) {
+	return std.ToDurationE(value, converters...)
+}
+
+// ToDurationE is like the package-level ToDurationE but uses the options of c.
+func (c *Converter) ToDurationE(value interface{}, converters ...DurationConverter) (time.Duration, error) {
 	for _, converter := range converters {
 		if result := converter(value); result != nil {
 			return *result, nil
 		}
 	}
 
-	i := Indirect(value)
+	if res, ok, err := fromRegistry[time.Duration](value); ok {
+		return res, err
+	}
+
+	i, err := indirectValue(value, reflect.TypeFor[time.Duration]())
+	if err != nil {
+		return 0, err
+	}
 
 	switch t := i.(type) {
 	case time.Duration:
@@ -194,11 +289,12 @@ func ToDurationE(value interface{}, converters ...DurationConverter) (time.Durat
 	case string:
 		d, err := time.ParseDuration(t)
 		if err != nil {
-			return 0, err
+			return 0, newError(t, reflect.TypeFor[time.Duration](), syntaxError(err))
 		}
 		return d, nil
 	default:
-		valueStr := ToString(t)
-		return ToDurationE(valueStr, converters...)
+		valueStr, _ := c.plainString(t)
+		res, err := c.ToDurationE(valueStr, converters...)
+		return res, stringFormError(err, value, reflect.TypeFor[time.Duration]())
 	}
 }
@@ -0,0 +1,219 @@
+package convert
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// UnixUnit is the unit of the Unix timestamps read and written by the time converters.
+type UnixUnit int
+
+const (
+	// UnixSeconds counts seconds since January 1, 1970 UTC.
+	UnixSeconds UnixUnit = iota
+	// UnixMilliseconds counts milliseconds since January 1, 1970 UTC.
+	UnixMilliseconds
+	// UnixMicroseconds counts microseconds since January 1, 1970 UTC.
+	UnixMicroseconds
+	// UnixNanoseconds counts nanoseconds since January 1, 1970 UTC.
+	UnixNanoseconds
+	// UnixAuto guesses the unit of timestamps from their magnitude: seconds below 1e11,
+	// which is in year 5138, then milliseconds below 1e14, microseconds below 1e17 and
+	// nanoseconds above. Timestamps are written in seconds.
+	UnixAuto
+)
+
+// String returns the name of the unit.
+func (u UnixUnit) String() string {
+	switch u {
+	case UnixSeconds:
+		return "s"
+	case UnixMilliseconds:
+		return "ms"
+	case UnixMicroseconds:
+		return "µs"
+	case UnixNanoseconds:
+		return "ns"
+	case UnixAuto:
+		return "auto"
+	default:
+		return "UnixUnit(" + strconv.Itoa(int(u)) + ")"
+	}
+}
+
+// nanoseconds returns the number of nanoseconds in one u; UnixAuto counts as seconds.
+func (u UnixUnit) nanoseconds() int64 {
+	switch u {
+	case UnixMilliseconds:
+		return int64(time.Millisecond)
+	case UnixMicroseconds:
+		return int64(time.Microsecond)
+	case UnixNanoseconds:
+		return 1
+	default:
+		return int64(time.Second)
+	}
+}
+
+// unixAutoBounds are the magnitudes from which UnixAuto reads timestamps in the next unit.
+var unixAutoBounds = []struct {
+	bound *big.Rat
+	unit  UnixUnit
+}{
+	{big.NewRat(1e11, 1), UnixMilliseconds},
+	{big.NewRat(1e14, 1), UnixMicroseconds},
+	{big.NewRat(1e17, 1), UnixNanoseconds},
+}
+
+// WithUnixTime makes ToTimeE read numbers as Unix timestamps in the given unit, and
+// the integer and float converters, like ToIntE, ToUint64E or ToFloat64E, write time.Time
+// values as Unix timestamps in that unit, rounded down for integers.
+// Numbers are integer, unsigned integer, float and big number types, json.Number and
+// strings holding a decimal number, like "1700000000" or "1.7e9"; other strings are
+// parsed as dates as usual. Fractions of nanoseconds are rounded with the rounding mode
+// of the converter, and times are returned in the location of WithTimeLocation, or the
+// local one. By default, numbers are not timestamps.
+//
+// Example:
+//
+//	events := convert.New(convert.WithUnixTime(convert.UnixAuto))
+//	t, err := events.ToTimeE(1700000000123) // 2023-11-14T22:13:20.123Z, read in milliseconds
+func WithUnixTime(unit UnixUnit) Option {
+	return func(o *options) {
+		o.unixTime = true
+		o.unixUnit = unit
+	}
+}
+
+// ToUnix converts any type of value to a Unix timestamp in the given unit, ignoring errors.
+func ToUnix(value interface{}, unit UnixUnit) int64 {
+	return std.ToUnix(value, unit)
+}
+
+// ToUnix is like the package-level ToUnix but uses the options of c.
+func (c *Converter) ToUnix(value interface{}, unit UnixUnit) int64 {
+	res, _ := c.ToUnixE(value, unit)
+	return res
+}
+
+// ToUnixE converts value to time.Time like ToTimeE does and returns it as a Unix timestamp
+// in the given unit, rounded down; UnixAuto gives seconds. Times beyond the int64 range of
+// the unit, like the years before 1678 or after 2262 in nanoseconds, are reported as ErrOverflow.
+//
+// Example:
+//
+//	ms, err := convert.ToUnixE("2023-11-14T22:13:20Z", convert.UnixMilliseconds)
+//	fmt.Println(ms) // Output: 1700000000000
+func ToUnixE(value interface{}, unit UnixUnit) (int64, error) {
+	return std.ToUnixE(value, unit)
+}
+
+// ToUnixE is like the package-level ToUnixE but uses the options of c.
+func (c *Converter) ToUnixE(value interface{}, unit UnixUnit) (int64, error) {
+	t, err := c.ToTimeE(value)
+	if err != nil {
+		return 0, err
+	}
+	return unixInteger[int64](c, value, t, unit)
+}
+
+// unixTime converts the indirected value i to a time if it is a number and c reads
+// numbers as Unix timestamps. It reports false for other values.
+func (c *Converter) unixTime(value interface{}, i interface{}) (time.Time, bool, error) {
+	if !c.opts.unixTime {
+		return time.Time{}, false, nil
+	}
+
+	to := reflect.TypeFor[time.Time]()
+	var r *big.Rat
+	switch n := basicValue(numberValue(i)).(type) {
+	case string:
+		if n == "" || numberPrefix(n) != len(n) {
+			return time.Time{}, false, nil
+		}
+		var ok bool
+		if r, ok = new(big.Rat).SetString(n); !ok {
+			return time.Time{}, false, nil
+		}
+	case []byte:
+		return c.unixTime(value, string(n))
+	case int, int8, int16, int32, int64:
+		r = new(big.Rat).SetInt64(reflect.ValueOf(n).Int())
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		r = new(big.Rat).SetUint64(reflect.ValueOf(n).Uint())
+	case float32, float64:
+		f := reflect.ValueOf(n).Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return time.Time{}, true, newError(value, to, ErrOverflow)
+		}
+		bitSize := 64
+		if _, ok := n.(float32); ok {
+			bitSize = 32
+		}
+		r, _ = new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, bitSize))
+	case big.Int:
+		r = new(big.Rat).SetInt(&n)
+	case big.Rat:
+		r = new(big.Rat).Set(&n)
+	case big.Float:
+		if n.IsInf() {
+			return time.Time{}, true, newError(value, to, ErrOverflow)
+		}
+		r, _ = n.Rat(nil)
+	default:
+		return time.Time{}, false, nil
+	}
+
+	unit := c.opts.unixUnit
+	if unit == UnixAuto {
+		unit = UnixSeconds
+		abs := new(big.Rat).Abs(r)
+		for _, b := range unixAutoBounds {
+			if abs.Cmp(b.bound) >= 0 {
+				unit = b.unit
+			}
+		}
+	}
+
+	ns, err := c.roundRat(value, r.Mul(r, big.NewRat(unit.nanoseconds(), 1)), to)
+	if err != nil {
+		return time.Time{}, true, err
+	}
+	sec, nsec := new(big.Int).DivMod(ns, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return time.Time{}, true, newError(value, to, ErrOverflow)
+	}
+
+	res := time.Unix(sec.Int64(), nsec.Int64())
+	if c.opts.timeLocation != nil {
+		res = res.In(c.opts.timeLocation)
+	}
+	return res, true, nil
+}
+
+// unixNanoseconds returns the number of nanoseconds of t since the Unix epoch.
+func unixNanoseconds(t time.Time) *big.Int {
+	ns := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(int64(time.Second)))
+	return ns.Add(ns, big.NewInt(int64(t.Nanosecond())))
+}
+
+// unixInteger returns t as a Unix timestamp in unit, rounded down, for the conversion
+// of value to the integer type T.
+func unixInteger[T integer](c *Converter, value interface{}, t time.Time, unit UnixUnit) (T, error) {
+	res := new(big.Int).Div(unixNanoseconds(t), big.NewInt(unit.nanoseconds()))
+	return bigToInteger[T](c, value, *res)
+}
+
+// unixFloat returns t as a Unix timestamp in unit, with its fraction.
+func unixFloat[T float](t time.Time, unit UnixUnit) T {
+	r := new(big.Rat).SetFrac(unixNanoseconds(t), big.NewInt(unit.nanoseconds()))
+	if isFloat32[T]() {
+		res, _ := r.Float32()
+		return T(res)
+	}
+	res, _ := r.Float64()
+	return T(res)
+}
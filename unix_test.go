@@ -0,0 +1,179 @@
+package convert
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithUnixTime(t *testing.T) {
+	base := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	tests := []struct {
+		name     string
+		unit     UnixUnit
+		input    interface{}
+		expected time.Time
+	}{
+		{"seconds", UnixSeconds, 1700000000, base},
+		{"negative seconds", UnixSeconds, int64(-1), time.Unix(-1, 0)},
+		{"fractional seconds", UnixSeconds, 1700000000.25, base.Add(250 * time.Millisecond)},
+		{"milliseconds", UnixMilliseconds, int64(1700000000123), base.Add(123 * time.Millisecond)},
+		{"microseconds", UnixMicroseconds, uint64(1700000000000001), base.Add(time.Microsecond)},
+		{"nanoseconds", UnixNanoseconds, int64(1700000000000000001), base.Add(1)},
+		{"numeric string", UnixSeconds, "1700000000", base},
+		{"exponent string", UnixSeconds, "1.7e9", base},
+		{"bytes", UnixMilliseconds, []byte("1700000000000"), base},
+		{"json.Number", UnixSeconds, json.Number("1700000000.5"), base.Add(500 * time.Millisecond)},
+		{"big.Int", UnixSeconds, big.NewInt(1700000000), base},
+		{"pointer", UnixSeconds, func() *int { n := 1700000000; return &n }(), base},
+		{"auto seconds", UnixAuto, 1700000000, base},
+		{"auto milliseconds", UnixAuto, int64(1700000000000), base},
+		{"auto microseconds", UnixAuto, int64(1700000000000000), base},
+		{"auto nanoseconds", UnixAuto, int64(1700000000000000000), base},
+		{"auto negative milliseconds", UnixAuto, int64(-1700000000000), time.Unix(-1700000000, 0)},
+		{"date string", UnixSeconds, "2023-11-14T22:13:20Z", base},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := New(WithUnixTime(tt.unit)).ToTimeE(tt.input)
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(res), "got %v, want %v", res, tt.expected)
+		})
+	}
+}
+
+func TestWithUnixTimeErrors(t *testing.T) {
+	c := New(WithUnixTime(UnixSeconds))
+
+	_, err := c.ToTimeE(math.NaN())
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = c.ToTimeE(new(big.Int).Lsh(big.NewInt(1), 70))
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = c.With(WithRounding(RoundError)).ToTimeE("1.0000000001")
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+	_, err = c.ToTimeE("not-a-date")
+	assert.Error(t, err)
+}
+
+func TestWithUnixTimeLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	res, err := New(WithUnixTime(UnixSeconds), WithTimeLocation(loc)).ToTimeE(0)
+	assert.NoError(t, err)
+	assert.Equal(t, loc, res.Location())
+	assert.Equal(t, 2, res.Hour())
+}
+
+func TestUnixTimeDisabled(t *testing.T) {
+	assert.True(t, ToTime(42).IsZero())
+	_, err := ToInt64E(time.Unix(1700000000, 0))
+	assert.Error(t, err)
+}
+
+func TestUnixTimeToNumbers(t *testing.T) {
+	ts := time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC)
+
+	n, err := New(WithUnixTime(UnixSeconds)).ToInt64E(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000), n)
+
+	n, err = New(WithUnixTime(UnixMilliseconds)).ToInt64E(&ts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000123), n)
+
+	n, err = New(WithUnixTime(UnixAuto)).ToInt64E(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000), n)
+
+	f, err := New(WithUnixTime(UnixSeconds)).ToFloat64E(ts)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1700000000.123456789, f, 1e-6)
+
+	f, err = New(WithUnixTime(UnixMilliseconds)).ToFloat64E(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, 1700000000123.456789, f)
+}
+
+func TestToUnixE(t *testing.T) {
+	ts := time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC)
+	tests := []struct {
+		unit     UnixUnit
+		expected int64
+	}{
+		{UnixSeconds, 1700000000},
+		{UnixMilliseconds, 1700000000123},
+		{UnixMicroseconds, 1700000000123456},
+		{UnixNanoseconds, 1700000000123456789},
+		{UnixAuto, 1700000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			res, err := ToUnixE(ts, tt.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+
+	res, err := ToUnixE("2023-11-14T22:13:20Z", UnixMilliseconds)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000000), res)
+
+	assert.Equal(t, int64(-1), ToUnix(time.Unix(0, -1), UnixSeconds))
+
+	_, err = ToUnixE(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC), UnixNanoseconds)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = ToUnixE("", UnixSeconds)
+	assert.ErrorIs(t, err, ErrEmptyString)
+}
+
+func TestUnixUnitString(t *testing.T) {
+	assert.Equal(t, "ms", UnixMilliseconds.String())
+	assert.Equal(t, "µs", UnixMicroseconds.String())
+	assert.Equal(t, "UnixUnit(9)", UnixUnit(9).String())
+}
+
+func TestUnixTimeSaturation(t *testing.T) {
+	c := New(WithUnixTime(UnixNanoseconds), WithSaturation(true))
+
+	n, err := c.ToInt64E(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), n)
+
+	n, err = c.ToInt64E(time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), n)
+
+	_, err = New(WithUnixTime(UnixNanoseconds)).ToInt64E(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestUnixTimeAllNumbers(t *testing.T) {
+	ts := time.Date(2023, 11, 14, 22, 13, 20, 500000000, time.UTC)
+	c := New(WithUnixTime(UnixSeconds))
+
+	i, err := c.ToIntE(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, 1700000000, i)
+
+	u, err := c.ToUint64E(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1700000000), u)
+
+	f, err := c.ToFloat32E(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1700000000.5), f)
+
+	g, err := ToWithE[int](c, ts)
+	assert.NoError(t, err)
+	assert.Equal(t, 1700000000, g)
+
+	_, err = c.ToInt16E(ts)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = c.ToUint32E(time.Unix(-1, 0))
+	assert.ErrorIs(t, err, ErrOverflow)
+}